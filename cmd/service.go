@@ -18,11 +18,13 @@
 package cmd
 
 import (
+	"context"
 	"log/slog"
 	"os"
 
 	"github.com/OutClimb/OutClimb/internal/app"
 	"github.com/OutClimb/OutClimb/internal/http"
+	"github.com/OutClimb/OutClimb/internal/mailer"
 	"github.com/OutClimb/OutClimb/internal/store"
 	"github.com/OutClimb/OutClimb/internal/utils"
 	"github.com/spf13/cobra"
@@ -77,7 +79,33 @@ func runService(cmd *cobra.Command, args []string) {
 
 	storeLayer.Migrate()
 
-	appLayer := app.New(storeLayer, &config.App)
+	mailTransport, err := mailer.New(&config.Mailer)
+	if err != nil {
+		slog.Error(
+			"Unable to set up email transport",
+			"layer", "cmd",
+			"entity", "service",
+			"transport", config.Mailer.Transport,
+			"error", err,
+		)
+		os.Exit(1)
+	}
+
+	webhooks := mailer.NewWebhookReceiver(&config.Mailer)
+
+	appLayer := app.New(storeLayer, mailTransport, webhooks, &config.App)
+	if mailTransport != nil {
+		go appLayer.RunEmailWorker(context.Background())
+	} else {
+		slog.Warn(
+			"No email transport or Resend API key configured, emails will stay queued",
+			"layer", "cmd",
+			"entity", "service",
+		)
+	}
+	go appLayer.RunReminderWorker(context.Background())
+	go appLayer.RunDigestWorker(context.Background())
+
 	httpLayer := http.New(appLayer, &config.Http, env)
 
 	httpLayer.Run()
@@ -18,9 +18,11 @@
 package app
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/mailer"
 	"github.com/OutClimb/OutClimb/internal/store"
 	"github.com/OutClimb/OutClimb/internal/utils"
 	"golang.org/x/crypto/bcrypt"
@@ -29,44 +31,89 @@ import (
 type AppLayer interface {
 	AuthenticateUser(username string, password string) (*models.UserInternal, error)
 	CreateAsset(user *models.UserInternal, fileName, contentType, data string) (*models.AssetInternal, error)
-	CreateEmail(user *models.UserInternal, name, slug, subject, htmlBody, textBody string) (*models.EmailInternal, error)
-	CreateForm(user *models.UserInternal, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, fields []FormFieldInput) (*models.FormInternal, error)
-	CreateLocation(user *models.UserInternal, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*models.LocationInternal, error)
+	CreateBroadcast(user *models.UserInternal, emailId, formId uint, filters []BroadcastFilterInput) (*models.BroadcastInternal, error)
+	CreateEmail(user *models.UserInternal, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*models.EmailInternal, error)
+	CreateEmailPartial(user *models.UserInternal, name, slug string, layout bool, htmlBody, textBody string) (*models.EmailPartialInternal, error)
+	CreateEmailSuppression(address string) (*models.EmailSuppressionInternal, error)
+	CreateEvent(user *models.UserInternal, title string, startsOn int64, endsOn *int64, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories []string) (*models.EventInternal, error)
+	CreateForm(user *models.UserInternal, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, event FormEventInput, fields []FormFieldInput) (*models.FormInternal, error)
+	CreateLocation(user *models.UserInternal, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*models.LocationInternal, error)
 	CreateRedirect(user *models.UserInternal, fromPath, toUrl string, startsOn, stopsOn int64) (*models.RedirectInternal, error)
 	CreateRole(user *models.UserInternal, name string, order uint, permissions map[string]uint) (*models.RoleInternal, error)
-	CreateSubmission(slug string, values map[string]string) (*models.SubmissionInternal, error)
+	CreateSubmission(slug string, values map[string]string, idempotencyKey string) (*models.SubmissionInternal, error)
 	CreateUser(user *models.UserInternal, disabled bool, email, name, password string, requirePasswordReset bool, username, roleName string) (*models.UserInternal, error)
 	DeleteAsset(id uint) error
 	DeleteEmail(id uint) error
+	DeleteEmailPartial(id uint) error
+	DeleteEmailSuppression(id uint) error
+	DeleteEvent(id uint) error
+	DeleteEventLocationOverride(eventKey string) error
 	DeleteForm(user *models.UserInternal, id uint) error
 	DeleteLocation(id uint) error
 	DeleteRedirect(id uint) error
 	DeleteRole(user *models.UserInternal, id uint) error
 	DeleteSubmission(user *models.UserInternal, submissionId uint) error
 	DeleteUser(user *models.UserInternal, id uint) error
+	DiffEmailRevisions(id, from, to uint) (*models.EmailRevisionDiffInternal, error)
 	FindAsset(fileName string) (string, error)
 	FindRedirect(path string) (*models.RedirectInternal, error)
 	GetAllAssets() (*[]models.AssetInternal, error)
-	GetEventsForMonth(year int, month time.Month) (*models.EventFeedInternal, error)
+	GetEvent(id uint) (*models.EventInternal, error)
+	GetEventDiagnostics() (*models.EventDiagnosticsInternal, error)
+	GetEventLocationOverrides() (*[]models.EventLocationOverrideInternal, error)
+	GetEvents(query EventQueryInput) (*models.EventPageInternal, error)
+	GetEventsCalendarForMonth(year int, month time.Month, filter EventFilter) ([]byte, error)
+	GetEventsForMonth(year int, month time.Month, filter EventFilter) (*models.EventFeedInternal, error)
+	GetEventPrograms() (*[]models.EventProgramInternal, error)
+	GetAllBroadcasts() (*[]models.BroadcastInternal, error)
+	GetAllEmailPartials() (*[]models.EmailPartialInternal, error)
 	GetAllEmails() (*[]models.EmailInternal, error)
+	GetAllEmailSuppressions() (*[]models.EmailSuppressionInternal, error)
+	GetAllEvents() (*[]models.EventInternal, error)
 	GetAllForms() (*[]models.FormInternal, error)
 	GetAllLocations() (*[]models.LocationInternal, error)
 	GetAllRedirects() (*[]models.RedirectInternal, error)
 	GetAllRoles() (*[]models.RoleInternal, error)
 	GetAllUsers() (*[]models.UserInternal, error)
 	GetAsset(id uint) (*models.AssetInternal, error)
+	GetBroadcast(id uint) (*models.BroadcastInternal, error)
+	GetDigestPreference(user *models.UserInternal) (*models.DigestPreferenceInternal, error)
 	GetEmail(id uint) (*models.EmailInternal, error)
+	GetEmailPartial(id uint) (*models.EmailPartialInternal, error)
+	GetEmailRevision(id, revision uint) (*models.EmailRevisionInternal, error)
+	GetEmailRevisions(id uint) (*[]models.EmailRevisionInternal, error)
+	GetEmailUsage(id uint) (*[]models.EmailUsageInternal, error)
+	GetEmailsForSubmission(user *models.UserInternal, submissionId uint) (*[]models.OutboundEmailInternal, error)
 	GetForm(user *models.UserInternal, id uint) (*models.FormInternal, error)
 	GetFormBySlug(slug string) (*models.FormInternal, error)
+	GetSubmissionByReference(user *models.UserInternal, reference string) (*models.SubmissionInternal, error)
 	GetLocation(id uint) (*models.LocationInternal, error)
+	GetOutboundEmail(user *models.UserInternal, id uint) (*models.OutboundEmailInternal, error)
+	GetOutboundEmails(user *models.UserInternal, status string, formId, submissionId *uint, recipient string) (*[]models.OutboundEmailInternal, error)
 	GetRedirect(id uint) (*models.RedirectInternal, error)
 	GetRole(id uint) (*models.RoleInternal, error)
 	GetSubmissionsForForm(user *models.UserInternal, formId uint) (*[]models.SubmissionInternal, error)
+	GetUpcomingEvents(limit, offset int, filter EventFilter) (*models.EventPageInternal, error)
+	GetUpcomingEventsCalendar(filter EventFilter) ([]byte, error)
+	GetUpcomingEventsFeed(filter EventFilter) (*models.EventFeedInternal, error)
 	GetUser(userId uint) (*models.UserInternal, error)
+	PreviewBroadcast(user *models.UserInternal, formId uint, filters []BroadcastFilterInput) (int, error)
+	PreviewEmail(user *models.UserInternal, id, formId uint, submissionId *uint, values map[string]string, sendTest bool) (*EmailPreview, error)
+	ReceiveEmailWebhook(header http.Header, body []byte) error
+	RefreshEvents() (*models.EventFeedInternal, error)
+	RenderMonthlySocialImages(user *models.UserInternal, input MonthlySocialImageInput) (*models.SocialImagesInternal, error)
+	RenderQtbipocSocialImage(user *models.UserInternal, input QtbipocSocialImageInput) (*models.SocialImagesInternal, error)
+	RestoreEmailRevision(user *models.UserInternal, id, revision uint) (*models.EmailInternal, error)
+	RetryOutboundEmail(user *models.UserInternal, id uint) (*models.OutboundEmailInternal, error)
+	SetEventLocationOverride(user *models.UserInternal, eventKey string, locationId *uint) (*models.EventLocationOverrideInternal, error)
+	Unsubscribe(address, token string) error
 	UpdateAsset(user *models.UserInternal, id uint, fileName, contentType, data string) (*models.AssetInternal, error)
-	UpdateEmail(user *models.UserInternal, id uint, name, slug, subject, htmlBody, textBody string) (*models.EmailInternal, error)
-	UpdateForm(user *models.UserInternal, id uint, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, fields []FormFieldInput) (*models.FormInternal, error)
-	UpdateLocation(user *models.UserInternal, id uint, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*models.LocationInternal, error)
+	UpdateDigestPreference(user *models.UserInternal, enabled bool, weekday, hour uint) (*models.DigestPreferenceInternal, error)
+	UpdateEmail(user *models.UserInternal, id uint, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*models.EmailInternal, error)
+	UpdateEmailPartial(user *models.UserInternal, id uint, name, slug string, layout bool, htmlBody, textBody string) (*models.EmailPartialInternal, error)
+	UpdateEvent(user *models.UserInternal, id uint, title string, startsOn int64, endsOn *int64, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories []string) (*models.EventInternal, error)
+	UpdateForm(user *models.UserInternal, id uint, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, event FormEventInput, fields []FormFieldInput) (*models.FormInternal, error)
+	UpdateLocation(user *models.UserInternal, id uint, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*models.LocationInternal, error)
 	UpdatePassword(user *models.UserInternal, password string) error
 	UpdateRedirect(user *models.UserInternal, id uint, fromPath, toUrl string, startsOn, stopsOn int64) (*models.RedirectInternal, error)
 	UpdateRole(user *models.UserInternal, id uint, name string, order uint, permissions map[string]uint) (*models.RoleInternal, error)
@@ -77,15 +124,25 @@ type AppLayer interface {
 type appLayer struct {
 	config    *utils.AppConfig
 	store     store.StoreLayer
+	mailer    mailer.Mailer
+	webhooks  mailer.WebhookReceiver
+	location  *time.Location
 	dummyHash []byte
+
+	socialFonts *socialFonts
 }
 
-func New(storeLayer store.StoreLayer, config *utils.AppConfig) *appLayer {
+func New(storeLayer store.StoreLayer, mailer mailer.Mailer, webhooks mailer.WebhookReceiver, config *utils.AppConfig) *appLayer {
 	dummyHash, _ := bcrypt.GenerateFromPassword([]byte("dummy_password"), config.PasswordCost)
 
 	return &appLayer{
 		config:    config,
 		store:     storeLayer,
+		mailer:    mailer,
+		webhooks:  webhooks,
+		location:  loadLocationOrDefault(config.Timezone),
 		dummyHash: dummyHash,
+
+		socialFonts: loadSocialFonts(config.SocialFontDirectory),
 	}
 }
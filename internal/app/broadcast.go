@@ -0,0 +1,305 @@
+//
+// Broadcast Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const defaultBroadcastRatePerMinute = 60
+
+type BroadcastFilterInput struct {
+	FieldSlug string
+	Value     string
+}
+
+type broadcastRecipient struct {
+	Submission store.Submission
+	Address    string
+	Values     map[string]string
+}
+
+func broadcastFilterMatches(field store.FormField, value, want string) bool {
+	if value == want {
+		return true
+	}
+
+	if field.Type == "checkboxes" {
+		return slices.Contains(strings.Split(value, ", "), want)
+	}
+
+	return false
+}
+
+// resolveBroadcastRecipients finds every registrant of the form matching all
+// filters who gave an address in the form's confirmation email field. Each
+// address is only included once, and suppressed addresses are left out.
+func (a *appLayer) resolveBroadcastRecipients(user *models.UserInternal, formId uint, filters []BroadcastFilterInput) (*store.Form, []store.FormField, []broadcastRecipient, error) {
+	form, err := a.store.GetForm(formId)
+	if err != nil {
+		return nil, nil, nil, ErrFormNotFound
+	}
+
+	fields, err := a.store.GetAllFormFieldsForForm(formId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	formInternal := models.FormInternal{}
+	formInternal.Internalize(form, fields)
+	if !canViewSubmissions(user, &formInternal) {
+		return nil, nil, nil, ErrForbidden
+	}
+
+	problems := &ValidationError{}
+
+	if !isSet(form.ConfirmationEmailFieldSlug) {
+		problems.add("formId", "form has no confirmation email field to send to")
+	}
+
+	fieldBySlug := map[string]store.FormField{}
+	fieldSlugByID := map[uint]string{}
+	for _, f := range *fields {
+		fieldBySlug[f.Slug] = f
+		fieldSlugByID[f.ID] = f.Slug
+	}
+
+	for i, filter := range filters {
+		if _, ok := fieldBySlug[filter.FieldSlug]; !ok {
+			problems.add(fmt.Sprintf("filters[%d].fieldSlug", i), "no field with slug \""+filter.FieldSlug+"\"")
+		}
+	}
+
+	if err := problems.errOrNil(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	submissions, err := a.store.GetSubmissionsForForm(formId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	recipients := []broadcastRecipient{}
+	seen := map[string]bool{}
+	for _, submission := range *submissions {
+		storedValues, err := a.store.GetAllSubmissionValueForSubmission(submission.ID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		values := submissionValueMap(storedValues, fieldSlugByID)
+
+		matches := true
+		for _, filter := range filters {
+			if !broadcastFilterMatches(fieldBySlug[filter.FieldSlug], values[filter.FieldSlug], filter.Value) {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+
+		address := strings.TrimSpace(values[*form.ConfirmationEmailFieldSlug])
+		if len(address) == 0 || seen[strings.ToLower(address)] {
+			continue
+		}
+		seen[strings.ToLower(address)] = true
+
+		recipients = append(recipients, broadcastRecipient{
+			Submission: submission,
+			Address:    address,
+			Values:     values,
+		})
+	}
+
+	addresses := make([]string, len(recipients))
+	for i, recipient := range recipients {
+		addresses[i] = recipient.Address
+	}
+
+	suppressed, err := suppressedAddresses(a.store, addresses)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	recipients = slices.DeleteFunc(recipients, func(recipient broadcastRecipient) bool {
+		return suppressed[normalizeEmailAddress(recipient.Address)]
+	})
+
+	return form, *fields, recipients, nil
+}
+
+func (a *appLayer) loadBroadcastInternal(broadcast *store.Broadcast) (*models.BroadcastInternal, error) {
+	counts, err := a.store.CountOutboundEmailsForBroadcast(broadcast.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.BroadcastInternal{}
+	internal.Internalize(broadcast, counts)
+	return &internal, nil
+}
+
+// CreateBroadcast renders the email once per recipient and queues the copies,
+// spaced out to the configured rate. Nothing is queued if any copy fails to
+// render.
+func (a *appLayer) CreateBroadcast(user *models.UserInternal, emailId, formId uint, filters []BroadcastFilterInput) (*models.BroadcastInternal, error) {
+	if !a.bulkEmailConfigured() {
+		return nil, ErrBulkEmailNotConfigured
+	}
+
+	email, err := a.GetEmail(emailId)
+	if err != nil {
+		return nil, ErrEmailNotFound
+	}
+
+	form, fields, recipients, err := a.resolveBroadcastRecipients(user, formId, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := a.loadEmailTemplateSet(a.store, email.LayoutSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := &ValidationError{}
+	if len(recipients) == 0 {
+		problems.add("filters", "no registrants match")
+	}
+
+	rendered := make([]*renderedEmail, len(recipients))
+	for i, recipient := range recipients {
+		data := emailTemplateData{
+			Form:           form,
+			Fields:         fields,
+			Values:         recipient.Values,
+			Reference:      recipient.Submission.Reference,
+			UnsubscribeURL: a.unsubscribeURL(recipient.Address),
+		}
+
+		rendered[i], err = renderEmail(email, set, data)
+		if err != nil {
+			problems.add("emailId", "unable to render for "+recipient.Submission.Reference+": "+err.Error())
+			continue
+		}
+		addUnsubscribeFooter(rendered[i], data.UnsubscribeURL)
+	}
+
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	var filtersJson *string
+	if len(filters) > 0 {
+		stored := make([]models.BroadcastFilterInternal, len(filters))
+		for i, filter := range filters {
+			stored[i] = models.BroadcastFilterInternal{FieldSlug: filter.FieldSlug, Value: filter.Value}
+		}
+
+		encoded, err := json.Marshal(stored)
+		if err != nil {
+			return nil, err
+		}
+		value := string(encoded)
+		filtersJson = &value
+	}
+
+	ratePerMinute := a.config.BroadcastRatePerMinute
+	if ratePerMinute <= 0 {
+		ratePerMinute = defaultBroadcastRatePerMinute
+	}
+	spacing := time.Minute / time.Duration(ratePerMinute)
+
+	var broadcast *store.Broadcast
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
+		var err error
+		broadcast, err = tx.CreateBroadcast(user.Username, email.Slug, form.ID, filtersJson, uint(len(recipients)))
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		for i, recipient := range recipients {
+			sendAt := start.Add(time.Duration(i) * spacing)
+			if _, err := tx.CreateBroadcastEmail(broadcast.ID, email.Slug, email.Revision, form.ID, recipient.Submission.ID, recipient.Address, rendered[i].Subject, rendered[i].HtmlBody, rendered[i].TextBody, sendAt); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.Error("Unable to create broadcast",
+			"layer", "app",
+			"entity", "broadcast",
+			"formId", formId,
+			"emailId", emailId,
+			"error", err,
+		)
+		return nil, err
+	}
+
+	return a.loadBroadcastInternal(broadcast)
+}
+
+func (a *appLayer) GetAllBroadcasts() (*[]models.BroadcastInternal, error) {
+	broadcasts, err := a.store.GetAllBroadcasts()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.BroadcastInternal, len(*broadcasts))
+	for i := range *broadcasts {
+		internal, err := a.loadBroadcastInternal(&(*broadcasts)[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *internal
+	}
+	return &result, nil
+}
+
+func (a *appLayer) GetBroadcast(id uint) (*models.BroadcastInternal, error) {
+	broadcast, err := a.store.GetBroadcast(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.loadBroadcastInternal(broadcast)
+}
+
+// PreviewBroadcast counts the recipients a broadcast would reach without
+// sending anything.
+func (a *appLayer) PreviewBroadcast(user *models.UserInternal, formId uint, filters []BroadcastFilterInput) (int, error) {
+	_, _, recipients, err := a.resolveBroadcastRecipients(user, formId, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(recipients), nil
+}
@@ -0,0 +1,208 @@
+//
+// Calendar Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/mailer"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const (
+	icalTimeFormat       = "20060102T150405Z"
+	icalDateFormat       = "20060102"
+	icalLineLimit        = 75
+	calendarInviteName   = "invite.ics"
+	calendarInviteType   = "text/calendar; charset=utf-8; method=PUBLISH"
+	calendarProductId    = "-//OutClimb//OutClimb//EN"
+	calendarDefaultHours = 2
+)
+
+// icalEvent is a single VEVENT. All day events only use the date of Start
+// and End, with End being the day after the event finishes.
+type icalEvent struct {
+	UID         string
+	Sequence    uint
+	Start       time.Time
+	End         *time.Time
+	AllDay      bool
+	Summary     string
+	Location    string
+	Latitude    *float64
+	Longitude   *float64
+	Description string
+	URL         string
+	Categories  []string
+}
+
+// escapeICalText escapes a TEXT value as RFC 5545 section 3.3.11 describes.
+func escapeICalText(s string) string {
+	return strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\r\n", "\\n",
+		"\n", "\\n",
+	).Replace(s)
+}
+
+// writeICalLine folds a content line at 75 octets without splitting a UTF-8
+// sequence, continuing on lines that start with a space.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = icalLineLimit - 1
+	}
+	b.WriteString(line + "\r\n")
+}
+
+// writeICalendar renders events as an RFC 5545 calendar, stamped with the
+// time the information was current as of.
+func writeICalendar(method string, stampedAt time.Time, events []icalEvent) []byte {
+	var b strings.Builder
+	stamp := stampedAt.UTC().Format(icalTimeFormat)
+
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:"+calendarProductId)
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	if len(method) > 0 {
+		writeICalLine(&b, "METHOD:"+method)
+	}
+
+	for _, event := range events {
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+event.UID)
+		writeICalLine(&b, "SEQUENCE:"+strconv.FormatUint(uint64(event.Sequence), 10))
+		writeICalLine(&b, "DTSTAMP:"+stamp)
+		if event.AllDay {
+			writeICalLine(&b, "DTSTART;VALUE=DATE:"+event.Start.Format(icalDateFormat))
+			if event.End != nil {
+				writeICalLine(&b, "DTEND;VALUE=DATE:"+event.End.Format(icalDateFormat))
+			}
+		} else {
+			writeICalLine(&b, "DTSTART:"+event.Start.UTC().Format(icalTimeFormat))
+			if event.End != nil {
+				writeICalLine(&b, "DTEND:"+event.End.UTC().Format(icalTimeFormat))
+			}
+		}
+		writeICalLine(&b, "SUMMARY:"+escapeICalText(event.Summary))
+		if len(event.Location) > 0 {
+			writeICalLine(&b, "LOCATION:"+escapeICalText(event.Location))
+		}
+		if event.Latitude != nil && event.Longitude != nil {
+			writeICalLine(&b, "GEO:"+strconv.FormatFloat(*event.Latitude, 'f', 6, 64)+";"+strconv.FormatFloat(*event.Longitude, 'f', 6, 64))
+		}
+		if len(event.Description) > 0 {
+			writeICalLine(&b, "DESCRIPTION:"+escapeICalText(event.Description))
+		}
+		if len(event.URL) > 0 {
+			writeICalLine(&b, "URL:"+event.URL)
+		}
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = escapeICalText(category)
+			}
+			writeICalLine(&b, "CATEGORIES:"+strings.Join(categories, ","))
+		}
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// locationText joins a location's name and its multi-line address into a
+// single LOCATION value.
+func locationText(name, address string) string {
+	parts := []string{name}
+	for _, line := range strings.Split(address, "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			parts = append(parts, line)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// calendarDomain is the domain calendar UIDs are scoped to, taken from the
+// address email is sent from.
+func (a *appLayer) calendarDomain() string {
+	address := a.config.EmailFromAddress
+	if parsed, err := mail.ParseAddress(address); err == nil {
+		address = parsed.Address
+	}
+
+	if at := strings.LastIndex(address, "@"); at >= 0 && at < len(address)-1 {
+		return address[at+1:]
+	}
+
+	return "outclimb"
+}
+
+// formCalendarInvite builds the .ics attachment for a form's confirmation
+// email, or returns nil when the form has no event or does not send invites.
+// The UID only depends on the form so a later invite replaces an earlier one.
+func (a *appLayer) formCalendarInvite(tx store.StoreLayer, form *store.Form) (*mailer.Attachment, error) {
+	if !form.CalendarInvite || form.EventStartsAt == nil {
+		return nil, nil
+	}
+
+	event := icalEvent{
+		UID:      "form-" + strconv.FormatUint(uint64(form.ID), 10) + "@" + a.calendarDomain(),
+		Sequence: form.EventSequence,
+		Start:    *form.EventStartsAt,
+		End:      form.EventEndsAt,
+		Summary:  form.Name,
+	}
+
+	if event.End == nil {
+		end := event.Start.Add(calendarDefaultHours * time.Hour)
+		event.End = &end
+	}
+
+	if form.EventLocationID != nil {
+		location, err := tx.GetLocation(*form.EventLocationID)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Location = locationText(location.Name, location.Address)
+		event.Latitude = location.Latitude
+		event.Longitude = location.Longitude
+		event.Description = location.Description
+	}
+
+	return &mailer.Attachment{
+		Filename:    calendarInviteName,
+		ContentType: calendarInviteType,
+		Content:     writeICalendar("PUBLISH", time.Now(), []icalEvent{event}),
+	}, nil
+}
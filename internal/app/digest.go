@@ -0,0 +1,373 @@
+//
+// Digest Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"bytes"
+	"context"
+	htmltemplate "html/template"
+	"log/slog"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const (
+	defaultDigestWorkerInterval = 5 * time.Minute
+	defaultDigestWeekday        = uint(time.Monday)
+	defaultDigestHour           = 8
+
+	// digestEmailSlug names digests in the delivery log. They are built in
+	// code rather than from an email template.
+	digestEmailSlug = "digest"
+
+	// digestLookahead is how far ahead the digest looks for forms opening or
+	// closing and redirects expiring.
+	digestLookahead = 7 * 24 * time.Hour
+)
+
+type digestForm struct {
+	Name           string
+	NewSubmissions int64
+	Submissions    int64
+	MaxSubmissions *uint
+	Opens          string
+	Closes         string
+}
+
+type digestRedirect struct {
+	FromPath string
+	ToUrl    string
+	Stops    string
+}
+
+type digestData struct {
+	Name      string
+	Since     string
+	Forms     []digestForm
+	Redirects []digestRedirect
+	ManageURL string
+}
+
+var digestHtmlTemplate = htmltemplate.Must(htmltemplate.New("digest").Parse(`<p>Hi {{.Name}},</p>
+<p>Here is what happened since {{.Since}}.</p>
+{{- if .Forms}}
+<h2>Forms</h2>
+<table cellpadding="6" cellspacing="0" style="border-collapse: collapse;">
+  <tr>
+    <th align="left">Form</th>
+    <th align="right">New</th>
+    <th align="right">Registered</th>
+    <th align="left">This week</th>
+  </tr>
+{{- range .Forms}}
+  <tr>
+    <td style="border-top: 1px solid #ddd;">{{.Name}}</td>
+    <td align="right" style="border-top: 1px solid #ddd;">{{.NewSubmissions}}</td>
+    <td align="right" style="border-top: 1px solid #ddd;">{{.Submissions}}{{with .MaxSubmissions}} of {{.}}{{end}}</td>
+    <td style="border-top: 1px solid #ddd;">{{if .Opens}}Opens {{.Opens}}{{end}}{{if and .Opens .Closes}}<br>{{end}}{{if .Closes}}Closes {{.Closes}}{{end}}</td>
+  </tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Redirects}}
+<h2>Redirects expiring soon</h2>
+<ul>
+{{- range .Redirects}}
+  <li>{{.FromPath}} &rarr; {{.ToUrl}} stops {{.Stops}}</li>
+{{- end}}
+</ul>
+{{- end}}
+<p><a href="{{.ManageURL}}">Open the manager</a> to see more or to change when you get this digest.</p>
+`))
+
+var digestTextTemplate = template.Must(template.New("digest").Parse(`Hi {{.Name}},
+
+Here is what happened since {{.Since}}.
+{{- if .Forms}}
+
+Forms
+=====
+{{- range .Forms}}
+
+{{.Name}}
+  New: {{.NewSubmissions}}
+  Registered: {{.Submissions}}{{with .MaxSubmissions}} of {{.}}{{end}}
+{{- if .Opens}}
+  Opens {{.Opens}}
+{{- end}}
+{{- if .Closes}}
+  Closes {{.Closes}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if .Redirects}}
+
+Redirects expiring soon
+=======================
+{{range .Redirects}}
+{{.FromPath}} -> {{.ToUrl}} stops {{.Stops}}
+{{- end}}
+{{- end}}
+
+Open the manager to see more or to change when you get this digest:
+{{.ManageURL}}
+`))
+
+// lastDigestSlot is the most recent time at or before now that falls on the
+// given weekday and hour in location.
+func lastDigestSlot(now time.Time, location *time.Location, weekday, hour uint) time.Time {
+	local := now.In(location)
+	slot := time.Date(local.Year(), local.Month(), local.Day(), int(hour), 0, 0, 0, location)
+	slot = slot.AddDate(0, 0, -((int(local.Weekday()) - int(weekday) + 7) % 7))
+	if slot.After(now) {
+		slot = slot.AddDate(0, 0, -7)
+	}
+	return slot
+}
+
+func withinDigestLookahead(t *time.Time, now time.Time) bool {
+	return t != nil && !t.Before(now) && t.Before(now.Add(digestLookahead))
+}
+
+func (a *appLayer) GetDigestPreference(user *models.UserInternal) (*models.DigestPreferenceInternal, error) {
+	// Users who never chose are shown the defaults, switched off.
+	preference, err := a.store.GetDigestPreferenceForUser(user.ID)
+	if err != nil {
+		return &models.DigestPreferenceInternal{
+			Weekday: defaultDigestWeekday,
+			Hour:    defaultDigestHour,
+		}, nil
+	}
+
+	internal := models.DigestPreferenceInternal{}
+	internal.Internalize(preference)
+	return &internal, nil
+}
+
+// UpdateDigestPreference saves when the user wants their digest. Turning the
+// digest on starts it from now, so the first one does not go out straight away
+// covering everything since the last slot.
+func (a *appLayer) UpdateDigestPreference(user *models.UserInternal, enabled bool, weekday, hour uint) (*models.DigestPreferenceInternal, error) {
+	problems := &ValidationError{}
+	if weekday > 6 {
+		problems.add("weekday", "weekday must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if hour > 23 {
+		problems.add("hour", "hour must be between 0 and 23")
+	}
+	if enabled && len(strings.TrimSpace(user.Email)) == 0 {
+		problems.add("enabled", "you need an email address to get a digest")
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	current, err := a.GetDigestPreference(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastSentAt *time.Time
+	if enabled && !current.Enabled {
+		now := time.Now()
+		lastSentAt = &now
+	}
+
+	preference, err := a.store.SetDigestPreference(user.ID, enabled, weekday, hour, lastSentAt)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.DigestPreferenceInternal{}
+	internal.Internalize(preference)
+	return &internal, nil
+}
+
+// RunDigestWorker queues digests as they come due until ctx is cancelled.
+func (a *appLayer) RunDigestWorker(ctx context.Context) {
+	interval := parseDurationOrDefault(a.config.DigestWorkerInterval, defaultDigestWorkerInterval, "digest worker interval")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		a.processDigests(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *appLayer) processDigests(now time.Time) {
+	preferences, err := a.store.GetEnabledDigestPreferences()
+	if err != nil {
+		slog.Error("Unable to get digest preferences",
+			"layer", "app",
+			"entity", "digest",
+			"error", err,
+		)
+		return
+	}
+
+	for i := range *preferences {
+		preference := &(*preferences)[i]
+
+		slot := lastDigestSlot(now, a.location, preference.Weekday, preference.Hour)
+		if preference.LastSentAt != nil && !preference.LastSentAt.Before(slot) {
+			continue
+		}
+
+		if err := a.sendDigest(preference, slot, now); err != nil {
+			slog.Error("Unable to send digest",
+				"layer", "app",
+				"entity", "digest",
+				"userId", preference.UserID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// sendDigest builds the user's digest and queues it. The slot is claimed in
+// the same transaction, so each digest is queued once even across restarts.
+func (a *appLayer) sendDigest(preference *store.DigestPreference, slot, now time.Time) error {
+	user, err := a.GetUser(preference.UserID)
+	if err != nil {
+		return err
+	}
+
+	since := slot.AddDate(0, 0, -7)
+	if preference.LastSentAt != nil {
+		since = *preference.LastSentAt
+	}
+
+	data, err := a.buildDigest(user, since, now)
+	if err != nil {
+		return err
+	}
+
+	return a.store.WithTransaction(func(tx store.StoreLayer) error {
+		claimed, err := tx.ClaimDigest(preference.ID, slot)
+		if err != nil || !claimed {
+			return err
+		}
+
+		// Nothing to report, or nobody to report it to.
+		if user.Disabled || len(user.Email) == 0 || (len(data.Forms) == 0 && len(data.Redirects) == 0) {
+			return nil
+		}
+
+		var html, text bytes.Buffer
+		if err := digestHtmlTemplate.Execute(&html, data); err != nil {
+			return err
+		}
+		if err := digestTextTemplate.Execute(&text, data); err != nil {
+			return err
+		}
+
+		subject := "Your weekly OutClimb digest"
+		_, err = tx.CreateOutboundEmail(digestEmailSlug, 0, nil, nil, []string{user.Email}, subject, html.String(), text.String())
+		return err
+	})
+}
+
+// buildDigest gathers activity on the forms the user can view and, when they
+// can see redirects, the redirects that stop within the week.
+func (a *appLayer) buildDigest(user *models.UserInternal, since, now time.Time) (*digestData, error) {
+	format := func(t *time.Time) string {
+		if !withinDigestLookahead(t, now) {
+			return ""
+		}
+		return t.In(a.location).Format(timeLayouts["datetime"])
+	}
+
+	data := digestData{
+		Name:      user.Name,
+		Since:     since.In(a.location).Format(timeLayouts["datetime"]),
+		Forms:     []digestForm{},
+		Redirects: []digestRedirect{},
+		ManageURL: strings.TrimRight(a.config.PublicURL, "/") + "/manage/",
+	}
+
+	forms, err := a.store.GetAllForms()
+	if err != nil {
+		return nil, err
+	}
+
+	emptyFields := []store.FormField{}
+	for i := range *forms {
+		form := &(*forms)[i]
+
+		formInternal := models.FormInternal{}
+		formInternal.Internalize(form, &emptyFields)
+		if !canViewSubmissions(user, &formInternal) {
+			continue
+		}
+
+		newSubmissions, err := a.store.CountSubmissionsForFormSince(form.ID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		submissions, err := a.store.CountSubmissionsForForm(form.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := digestForm{
+			Name:           form.Name,
+			NewSubmissions: newSubmissions,
+			Submissions:    submissions,
+			MaxSubmissions: form.MaxSubmissions,
+			Opens:          format(form.OpensOn),
+			Closes:         format(form.ClosesOn),
+		}
+
+		// Forms that are neither busy nor changing this week are left out.
+		if entry.NewSubmissions == 0 && len(entry.Opens) == 0 && len(entry.Closes) == 0 {
+			continue
+		}
+		data.Forms = append(data.Forms, entry)
+	}
+
+	if user.Role == "Owner" || user.Permissions["redirect"] >= uint(store.LevelRead) {
+		redirects, err := a.store.GetAllRedirects()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, redirect := range *redirects {
+			if withinDigestLookahead(redirect.StopsOn, now) {
+				data.Redirects = append(data.Redirects, digestRedirect{
+					FromPath: redirect.FromPath,
+					ToUrl:    redirect.ToUrl,
+					Stops:    format(redirect.StopsOn),
+				})
+			}
+		}
+	}
+
+	return &data, nil
+}
@@ -18,66 +18,394 @@
 package app
 
 import (
-	"bytes"
-	htmltemplate "html/template"
+	"errors"
 	"log/slog"
+	"slices"
+	"strings"
 	"text/template"
 
 	"github.com/OutClimb/OutClimb/internal/app/models"
-	"github.com/resend/resend-go/v3"
+	"github.com/OutClimb/OutClimb/internal/mailer"
+	"github.com/OutClimb/OutClimb/internal/store"
 )
 
-func (a *appLayer) sendEmail(to []string, email *models.EmailInternal, data interface{}) error {
-	if len(a.config.ResendApiKey) == 0 {
-		slog.Warn("Resend API key not configured, skipping email",
+var (
+	ErrEmailInUse    = errors.New("email is used by a form")
+	ErrEmailNotFound = errors.New("email not found")
+)
+
+// previewReference stands in for a submission reference when previewing
+// against sample values.
+const previewReference = "OC-SAMPLE"
+
+type EmailPreview struct {
+	Subject    string
+	HtmlBody   string
+	TextBody   string
+	Problems   []ValidationProblem
+	TestQueued bool
+}
+
+// sampleFieldValue makes up a plausible answer for a field so templates can be
+// previewed before anyone has submitted the form.
+func sampleFieldValue(field store.FormField) string {
+	switch field.Type {
+	case "given-name":
+		return "Alex"
+	case "family-name":
+		return "Rivera"
+	case "email":
+		return "alex.rivera@example.com"
+	case "phone":
+		return "555-0100"
+	case "bool":
+		return "true"
+	case "checkboxes", "radios", "select":
+		if options, err := parseFieldOptions(field.Metadata); err == nil {
+			return options[0]
+		}
+		return ""
+	default:
+		return "Sample " + strings.ToLower(field.Name)
+	}
+}
+
+// enqueueEmail renders the template and queues the result, with any
+// attachments, for the email worker. Pass a transaction's store so the message
+// is only queued if the surrounding work commits. A template that fails to
+// render is queued already dead-lettered, holding the render error, so it
+// shows up in the outbox instead of vanishing.
+func (a *appLayer) enqueueEmail(tx store.StoreLayer, formId, submissionId *uint, to []string, email *models.EmailInternal, data interface{}, attachments []mailer.Attachment) error {
+	set, err := a.loadEmailTemplateSet(tx, email.LayoutSlug)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderEmail(email, set, data)
+	if err != nil {
+		slog.Error("Unable to render email",
 			"layer", "app",
 			"entity", "email",
 			"slug", email.Slug,
+			"error", err,
 		)
-		return nil
+
+		failed, createErr := tx.CreateOutboundEmail(email.Slug, email.Revision, formId, submissionId, to, "", "", "")
+		if createErr != nil {
+			return createErr
+		}
+		return tx.MarkOutboundEmailFailed(failed.ID, "unable to render email: "+err.Error(), nil)
 	}
 
-	subjectTmpl, err := template.New("subject").Parse(email.Subject)
+	outboundEmail, err := tx.CreateOutboundEmail(email.Slug, email.Revision, formId, submissionId, to, rendered.Subject, rendered.HtmlBody, rendered.TextBody)
 	if err != nil {
 		return err
 	}
-	var subjectBuf bytes.Buffer
-	if err := subjectTmpl.Execute(&subjectBuf, data); err != nil {
-		return err
+
+	for _, attachment := range attachments {
+		if _, err := tx.CreateOutboundEmailAttachment(outboundEmail.ID, attachment.Filename, attachment.ContentType, attachment.Content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// enqueueBulkEmail queues bulk email such as a reminder for a single
+// recipient, with the unsubscribe link broadcasts carry. Addresses on the
+// suppression list are skipped rather than queued.
+func (a *appLayer) enqueueBulkEmail(tx store.StoreLayer, formId, submissionId uint, address string, email *models.EmailInternal, data emailTemplateData) error {
+	if !a.bulkEmailConfigured() {
+		return ErrBulkEmailNotConfigured
 	}
 
-	htmlTmpl, err := htmltemplate.New("html").Parse(email.HtmlBody)
+	suppressed, err := suppressedAddresses(tx, []string{address})
 	if err != nil {
 		return err
 	}
-	var htmlBuf bytes.Buffer
-	if err := htmlTmpl.Execute(&htmlBuf, data); err != nil {
+	if suppressed[normalizeEmailAddress(address)] {
+		return nil
+	}
+
+	set, err := a.loadEmailTemplateSet(tx, email.LayoutSlug)
+	if err != nil {
 		return err
 	}
 
-	textTmpl, err := template.New("text").Parse(email.TextBody)
+	data.UnsubscribeURL = a.unsubscribeURL(address)
+	rendered, err := renderEmail(email, set, data)
+	if err != nil {
+		slog.Error("Unable to render email",
+			"layer", "app",
+			"entity", "email",
+			"slug", email.Slug,
+			"error", err,
+		)
+
+		failed, createErr := tx.CreateBulkEmail(email.Slug, email.Revision, formId, submissionId, address, "", "", "")
+		if createErr != nil {
+			return createErr
+		}
+		return tx.MarkOutboundEmailFailed(failed.ID, "unable to render email: "+err.Error(), nil)
+	}
+	addUnsubscribeFooter(rendered, data.UnsubscribeURL)
+
+	_, err = tx.CreateBulkEmail(email.Slug, email.Revision, formId, submissionId, address, rendered.Subject, rendered.HtmlBody, rendered.TextBody)
+	return err
+}
+
+func (a *appLayer) deliverEmail(outboundEmail *store.OutboundEmail) (string, error) {
+	stored, err := a.store.GetOutboundEmailAttachments(outboundEmail.ID)
+	if err != nil {
+		return "", err
+	}
+
+	attachments := make([]mailer.Attachment, len(*stored))
+	for i, attachment := range *stored {
+		attachments[i] = mailer.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		}
+	}
+
+	msg := &mailer.Message{
+		From:        a.config.EmailFromAddress,
+		To:          outboundEmail.Recipients(),
+		Subject:     outboundEmail.Subject,
+		HtmlBody:    outboundEmail.HtmlBody,
+		TextBody:    outboundEmail.TextBody,
+		Attachments: attachments,
+	}
+
+	// Bulk email goes to one recipient at a time, so the unsubscribe link
+	// can be theirs.
+	if outboundEmail.Bulk && len(msg.To) == 1 {
+		msg.Headers = a.unsubscribeHeaders(msg.To[0])
+	}
+
+	return a.mailer.Send(msg)
+}
+
+// emailUsage lists every form that sends the email with the given slug.
+func (a *appLayer) emailUsage(slug string) (*[]store.Form, []models.EmailUsageInternal, error) {
+	forms, err := a.store.GetFormsUsingEmail(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := []models.EmailUsageInternal{}
+	for i := range *forms {
+		form := &(*forms)[i]
+		if form.ConfirmationEmailSlug != nil && *form.ConfirmationEmailSlug == slug {
+			entry := models.EmailUsageInternal{}
+			entry.Internalize(form, "confirmation")
+			usage = append(usage, entry)
+		}
+		if form.NotificationEmailSlug != nil && *form.NotificationEmailSlug == slug {
+			entry := models.EmailUsageInternal{}
+			entry.Internalize(form, "notification")
+			usage = append(usage, entry)
+		}
+		for _, reminder := range form.Reminders {
+			if reminder.EmailSlug == slug {
+				entry := models.EmailUsageInternal{}
+				entry.Internalize(form, "reminder")
+				usage = append(usage, entry)
+				break
+			}
+		}
+	}
+
+	return forms, usage, nil
+}
+
+func formNames(forms *[]store.Form) string {
+	names := make([]string, len(*forms))
+	for i, form := range *forms {
+		names[i] = "\"" + form.Name + "\""
+	}
+	return strings.Join(names, ", ")
+}
+
+// validateEmailTemplate parses and test-renders an email before it is saved.
+// Value references are checked against the fields of every form that uses the
+// email, or against all form fields when no form uses it yet. When the bodies
+// were generated from Markdown, problems are reported against the Markdown.
+func (a *appLayer) validateEmailTemplate(id uint, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) error {
+	problems := &ValidationError{}
+
+	if len(strings.TrimSpace(name)) == 0 {
+		problems.add("name", "name is required")
+	}
+
+	if len(strings.TrimSpace(slug)) == 0 {
+		problems.add("slug", "slug is required")
+	} else if existing, err := a.store.GetEmailWithSlug(slug); err == nil && existing.ID != id {
+		problems.add("slug", "another email already uses slug \""+slug+"\"")
+	}
+
+	usageSlug := slug
+	if id != 0 {
+		existing, err := a.store.GetEmail(id)
+		if err != nil {
+			return ErrEmailNotFound
+		}
+		usageSlug = existing.Slug
+	}
+
+	forms, _, err := a.emailUsage(usageSlug)
 	if err != nil {
 		return err
 	}
-	var textBuf bytes.Buffer
-	if err := textTmpl.Execute(&textBuf, data); err != nil {
+
+	if usageSlug != slug && len(*forms) > 0 {
+		problems.add("slug", "slug cannot change while used by "+formNames(forms))
+	}
+
+	// Collect the fields each reference will be checked against.
+	formFields := map[uint][]store.FormField{}
+	for _, form := range *forms {
+		fields, err := a.store.GetAllFormFieldsForForm(form.ID)
+		if err != nil {
+			return err
+		}
+		formFields[form.ID] = *fields
+	}
+
+	var allFields []store.FormField
+	if len(*forms) == 0 {
+		fields, err := a.store.GetAllFormFields()
+		if err != nil {
+			return err
+		}
+		allFields = *fields
+	}
+
+	parts := []struct {
+		name string
+		text string
+	}{
+		{"subject", subject},
+		{"htmlBody", htmlBody},
+		{"textBody", textBody},
+	}
+
+	markdownParses := true
+	if markdownBody != nil {
+		parts[1].name, parts[1].text = "markdownBody", *markdownBody
+		parts = parts[:2]
+
+		if _, err := template.New("markdownBody").Funcs(emailTemplateFuncs(nil)).Parse(*markdownBody); err != nil {
+			addTemplateError(problems, "markdownBody", err)
+			markdownParses = false
+		}
+	}
+
+	for _, part := range parts {
+		refs, err := findValueReferences(part.text)
+		if err != nil {
+			// Reported with its line number by the render below.
+			continue
+		}
+
+		for _, ref := range refs {
+			if len(*forms) == 0 {
+				if !slices.ContainsFunc(allFields, func(f store.FormField) bool { return f.Slug == ref.Slug }) {
+					problems.addAt(part.name, ref.Line, "no form has a field with slug \""+ref.Slug+"\"")
+				}
+				continue
+			}
+
+			for _, form := range *forms {
+				if !slices.ContainsFunc(formFields[form.ID], func(f store.FormField) bool { return f.Slug == ref.Slug }) {
+					problems.addAt(part.name, ref.Line, "form \""+form.Name+"\" has no field with slug \""+ref.Slug+"\"")
+				}
+			}
+		}
+	}
+
+	// Rendering against sample data catches execution errors such as a
+	// misspelled .Form field.
+	sample := emailTemplateData{
+		Form:      &store.Form{Name: "Sample form", Slug: "sample-form"},
+		Fields:    allFields,
+		Values:    map[string]string{},
+		Reference: previewReference,
+	}
+	if len(*forms) > 0 {
+		sample.Form = &(*forms)[0]
+		sample.Fields = formFields[sample.Form.ID]
+	}
+	for _, f := range sample.Fields {
+		sample.Values[f.Slug] = sampleFieldValue(f)
+	}
+
+	set, err := a.loadEmailTemplateSet(a.store, layoutSlug)
+	if err != nil {
 		return err
 	}
 
-	client := resend.NewClient(a.config.ResendApiKey)
-	params := &resend.SendEmailRequest{
-		From:    a.config.EmailFromAddress,
-		To:      to,
-		Subject: subjectBuf.String(),
-		Html:    htmlBuf.String(),
-		Text:    textBuf.String(),
+	_, err = renderEmail(&models.EmailInternal{Slug: slug, LayoutSlug: layoutSlug, Subject: subject, HtmlBody: htmlBody, TextBody: textBody}, set, sample)
+	var renderProblems *ValidationError
+	if errors.As(err, &renderProblems) {
+		for _, problem := range renderProblems.Problems {
+			if markdownBody != nil && (problem.Field == "htmlBody" || problem.Field == "textBody") {
+				// Line numbers in the generated bodies mean nothing to
+				// the author, and a Markdown parse error was already
+				// reported above.
+				if !markdownParses {
+					continue
+				}
+				problem.Field = "markdownBody"
+				problem.Line = 0
+			}
+			problems.Problems = append(problems.Problems, problem)
+		}
 	}
-	_, err = client.Emails.Send(params)
-	return err
+
+	return problems.errOrNil()
 }
 
-func (a *appLayer) CreateEmail(user *models.UserInternal, name, slug, subject, htmlBody, textBody string) (*models.EmailInternal, error) {
-	email, err := a.store.CreateEmail(user.Username, name, slug, subject, htmlBody, textBody)
+// emailBodies returns the bodies to save for an email. With a Markdown source
+// both are generated from it and the given bodies are ignored.
+func emailBodies(markdownBody *string, htmlBody, textBody string) (*string, string, string, error) {
+	if !isSet(markdownBody) {
+		return nil, htmlBody, textBody, nil
+	}
+
+	htmlBody, textBody, err := markdownToEmailBodies(*markdownBody)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return markdownBody, htmlBody, textBody, nil
+}
+
+func (a *appLayer) CreateEmail(user *models.UserInternal, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*models.EmailInternal, error) {
+	if !isSet(layoutSlug) {
+		layoutSlug = nil
+	}
+
+	markdownBody, htmlBody, textBody, err := emailBodies(markdownBody, htmlBody, textBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validateEmailTemplate(0, name, slug, layoutSlug, subject, markdownBody, htmlBody, textBody); err != nil {
+		return nil, err
+	}
+
+	var email *store.Email
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
+		var err error
+		if email, err = tx.CreateEmail(user.Username, name, slug, layoutSlug, subject, markdownBody, htmlBody, textBody); err != nil {
+			return err
+		}
+
+		_, err = tx.CreateEmailRevision(email)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +416,20 @@ func (a *appLayer) CreateEmail(user *models.UserInternal, name, slug, subject, h
 }
 
 func (a *appLayer) DeleteEmail(id uint) error {
+	email, err := a.store.GetEmail(id)
+	if err != nil {
+		return ErrEmailNotFound
+	}
+
+	forms, _, err := a.emailUsage(email.Slug)
+	if err != nil {
+		return err
+	}
+
+	if len(*forms) > 0 {
+		return ErrEmailInUse
+	}
+
 	return a.store.DeleteEmail(id)
 }
 
@@ -115,8 +457,45 @@ func (a *appLayer) GetEmail(id uint) (*models.EmailInternal, error) {
 	return &internal, nil
 }
 
-func (a *appLayer) UpdateEmail(user *models.UserInternal, id uint, name, slug, subject, htmlBody, textBody string) (*models.EmailInternal, error) {
-	email, err := a.store.UpdateEmail(id, user.Username, name, slug, subject, htmlBody, textBody)
+func (a *appLayer) GetEmailUsage(id uint) (*[]models.EmailUsageInternal, error) {
+	email, err := a.store.GetEmail(id)
+	if err != nil {
+		return nil, ErrEmailNotFound
+	}
+
+	_, usage, err := a.emailUsage(email.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+func (a *appLayer) UpdateEmail(user *models.UserInternal, id uint, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*models.EmailInternal, error) {
+	if !isSet(layoutSlug) {
+		layoutSlug = nil
+	}
+
+	markdownBody, htmlBody, textBody, err := emailBodies(markdownBody, htmlBody, textBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validateEmailTemplate(id, name, slug, layoutSlug, subject, markdownBody, htmlBody, textBody); err != nil {
+		return nil, err
+	}
+
+	// Every save is kept so a bad edit can be rolled back.
+	var email *store.Email
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
+		var err error
+		if email, err = tx.UpdateEmail(id, user.Username, name, slug, layoutSlug, subject, markdownBody, htmlBody, textBody); err != nil {
+			return err
+		}
+
+		_, err = tx.CreateEmailRevision(email)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -125,3 +504,98 @@ func (a *appLayer) UpdateEmail(user *models.UserInternal, id uint, name, slug, s
 	internal.Internalize(email)
 	return &internal, nil
 }
+
+// PreviewEmail renders the template against a form's fields using either a
+// real submission, the given values, or made-up sample values. With sendTest
+// the rendered email is also queued to the requesting user.
+func (a *appLayer) PreviewEmail(user *models.UserInternal, id, formId uint, submissionId *uint, values map[string]string, sendTest bool) (*EmailPreview, error) {
+	email, err := a.GetEmail(id)
+	if err != nil {
+		return nil, ErrEmailNotFound
+	}
+
+	form, err := a.store.GetForm(formId)
+	if err != nil {
+		return nil, ErrFormNotFound
+	}
+
+	fields, err := a.store.GetAllFormFieldsForForm(formId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := emailTemplateData{
+		Form:      form,
+		Fields:    *fields,
+		Values:    values,
+		Reference: previewReference,
+	}
+
+	if submissionId != nil {
+		submission, err := a.store.GetSubmission(*submissionId)
+		if err != nil || submission.FormID != formId {
+			return nil, ErrSubmissionNotFound
+		}
+
+		formInternal := models.FormInternal{}
+		formInternal.Internalize(form, fields)
+		if !canViewSubmissions(user, &formInternal) {
+			return nil, ErrForbidden
+		}
+
+		storedValues, err := a.store.GetAllSubmissionValueForSubmission(submission.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldSlugByID := map[uint]string{}
+		for _, f := range *fields {
+			fieldSlugByID[f.ID] = f.Slug
+		}
+
+		data.Values = submissionValueMap(storedValues, fieldSlugByID)
+		data.Reference = submission.Reference
+	} else if data.Values == nil {
+		data.Values = map[string]string{}
+		for _, f := range *fields {
+			data.Values[f.Slug] = sampleFieldValue(f)
+		}
+	}
+
+	set, err := a.loadEmailTemplateSet(a.store, email.LayoutSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := renderEmail(email, set, data)
+
+	preview := EmailPreview{
+		Subject:  rendered.Subject,
+		HtmlBody: rendered.HtmlBody,
+		TextBody: rendered.TextBody,
+	}
+
+	var problems *ValidationError
+	if errors.As(err, &problems) {
+		preview.Problems = problems.Problems
+	}
+
+	if sendTest && err == nil && len(user.Email) == 0 {
+		preview.Problems = append(preview.Problems, ValidationProblem{Field: "sendTest", Message: "your account has no email address"})
+	} else if sendTest && err == nil {
+		// Tests are kept apart from the form and submission so they never
+		// show up in a registrant's delivery history.
+		if _, err := a.store.CreateOutboundEmail(email.Slug, email.Revision, nil, nil, []string{user.Email}, "[Test] "+rendered.Subject, rendered.HtmlBody, rendered.TextBody); err != nil {
+			slog.Error("Unable to queue test email",
+				"layer", "app",
+				"entity", "email",
+				"id", id,
+				"error", err,
+			)
+			return nil, err
+		}
+		preview.TestQueued = true
+	}
+
+	return &preview, nil
+}
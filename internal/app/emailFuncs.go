@@ -0,0 +1,250 @@
+//
+// Email Template Functions
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const defaultTimezone = "UTC"
+
+// timeLayouts are the named layouts formatTime accepts besides a Go layout.
+var timeLayouts = map[string]string{
+	"date":     "Monday, January 2, 2006",
+	"datetime": "Monday, January 2, 2006 at 3:04 PM MST",
+	"short":    "Jan 2, 2006",
+	"time":     "3:04 PM MST",
+}
+
+// emailAnswer is one answer as listed by the answers function.
+type emailAnswer struct {
+	Slug  string
+	Label string
+	Type  string
+	Value string
+}
+
+// loadLocationOrDefault loads the named timezone, falling back to UTC when it
+// is empty or unknown.
+func loadLocationOrDefault(name string) *time.Location {
+	if len(name) == 0 {
+		name = defaultTimezone
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		slog.Error("Failed to load timezone, using default",
+			"layer", "app",
+			"input", name,
+			"default", defaultTimezone,
+			"error", err,
+		)
+		return time.UTC
+	}
+
+	return location
+}
+
+// emailTemplateFuncs is the FuncMap every email template, partial and layout
+// is parsed with. Times are shown in location unless a template asks for
+// another timezone with formatTimeIn.
+func emailTemplateFuncs(location *time.Location) template.FuncMap {
+	if location == nil {
+		location = time.UTC
+	}
+
+	return template.FuncMap{
+		"answers":    templateAnswers,
+		"buildURL":   templateBuildURL,
+		"default":    templateDefault,
+		"fieldLabel": templateFieldLabel,
+		"formatDate": func(value any) (string, error) {
+			return formatTemplateTime(location, "date", value)
+		},
+		"formatTime": func(layout string, value any) (string, error) {
+			return formatTemplateTime(location, layout, value)
+		},
+		"formatTimeIn": func(timezone, layout string, value any) (string, error) {
+			zone, err := time.LoadLocation(timezone)
+			if err != nil {
+				return "", fmt.Errorf("unknown timezone %q", timezone)
+			}
+			return formatTemplateTime(zone, layout, value)
+		},
+		"pluralize": templatePluralize,
+	}
+}
+
+// formatTemplateTime formats a time.Time, *time.Time, Unix milliseconds or an
+// RFC 3339 string. Unset times format as an empty string.
+func formatTemplateTime(location *time.Location, layout string, value any) (string, error) {
+	var t time.Time
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case time.Time:
+		t = v
+	case *time.Time:
+		if v == nil {
+			return "", nil
+		}
+		t = *v
+	case int64:
+		if v == 0 {
+			return "", nil
+		}
+		t = time.UnixMilli(v)
+	case string:
+		if len(v) == 0 {
+			return "", nil
+		}
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a time", v)
+		}
+		t = parsed
+	default:
+		return "", fmt.Errorf("cannot format %T as a time", value)
+	}
+
+	if named, ok := timeLayouts[layout]; ok {
+		layout = named
+	}
+
+	return t.In(location).Format(layout), nil
+}
+
+// templateFieldLabel returns the name of the field with the given slug, or
+// the slug itself when the form has no such field.
+func templateFieldLabel(fields []store.FormField, slug string) string {
+	for _, field := range fields {
+		if field.Slug == slug {
+			return field.Name
+		}
+	}
+
+	return slug
+}
+
+// templateAnswers lists every field with its answer in the order the fields
+// appear on the form.
+func templateAnswers(fields []store.FormField, values map[string]string) []emailAnswer {
+	sorted := slices.Clone(fields)
+	slices.SortStableFunc(sorted, func(a, b store.FormField) int {
+		return int(a.Order) - int(b.Order)
+	})
+
+	answers := make([]emailAnswer, len(sorted))
+	for i, field := range sorted {
+		answers[i] = emailAnswer{
+			Slug:  field.Slug,
+			Label: field.Name,
+			Type:  field.Type,
+			Value: values[field.Slug],
+		}
+	}
+
+	return answers
+}
+
+// templatePluralize picks singular when count is one and plural otherwise.
+func templatePluralize(count any, singular, plural string) (string, error) {
+	var n float64
+	switch v := reflect.ValueOf(count); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		n = v.Float()
+	case reflect.String:
+		parsed, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a number", v.String())
+		}
+		n = parsed
+	default:
+		return "", fmt.Errorf("cannot count %T", count)
+	}
+
+	if n == 1 {
+		return singular, nil
+	}
+	return plural, nil
+}
+
+// templateBuildURL adds query parameters, given as key and value pairs, to a
+// base URL. Only http, https and mailto URLs are allowed so a submitted value
+// cannot turn a link into script.
+func templateBuildURL(base string, pairs ...any) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a URL", base)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "mailto":
+	default:
+		return "", fmt.Errorf("%q is not an http, https or mailto URL", base)
+	}
+
+	if len(pairs)%2 != 0 {
+		return "", errors.New("buildURL needs a value for every key")
+	}
+
+	query := u.Query()
+	for i := 0; i < len(pairs); i += 2 {
+		query.Add(fmt.Sprint(pairs[i]), fmt.Sprint(pairs[i+1]))
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// templateDefault returns value unless it is empty, in which case fallback is
+// returned. It reads well at the end of a pipeline:
+// {{.Values.pronouns | default "not given"}}.
+func templateDefault(fallback, value any) any {
+	if value == nil {
+		return fallback
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return fallback
+		}
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if v.Len() == 0 {
+			return fallback
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,227 @@
+//
+// Email Markdown Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var (
+	templateActionPattern      = regexp.MustCompile(`(?s){{.*?}}`)
+	actionPlaceholderPattern   = regexp.MustCompile(`OCACTION(\d+)END`)
+	actionOnlyParagraphPattern = regexp.MustCompile(`<p>((?:OCACTION\d+END\s*)+)</p>\n?`)
+	htmlTagPattern             = regexp.MustCompile(`<[^>]*>`)
+	blankLinesPattern          = regexp.MustCompile(`\n{3,}`)
+)
+
+var markdownConverter = goldmark.New(goldmark.WithRendererOptions(html.WithUnsafe()))
+
+// protectTemplateActions swaps every {{...}} action for a placeholder that
+// Markdown leaves alone, so underscores and asterisks inside an action are not
+// read as emphasis and quotes are not escaped.
+func protectTemplateActions(source string) (string, []string) {
+	actions := []string{}
+	protected := templateActionPattern.ReplaceAllStringFunc(source, func(action string) string {
+		actions = append(actions, action)
+		return "OCACTION" + strconv.Itoa(len(actions)-1) + "END"
+	})
+
+	return protected, actions
+}
+
+func restoreTemplateActions(s string, actions []string) string {
+	return actionPlaceholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		i, _ := strconv.Atoi(actionPlaceholderPattern.FindStringSubmatch(placeholder)[1])
+		if i >= len(actions) {
+			return placeholder
+		}
+		return actions[i]
+	})
+}
+
+// markdownToEmailBodies produces the HTML and plain-text bodies of an email
+// from its Markdown source. Template actions are carried through unchanged to
+// both. A paragraph holding nothing but actions, such as {{if ...}} on its own
+// line, is unwrapped so it does not leave an empty paragraph behind.
+func markdownToEmailBodies(source string) (string, string, error) {
+	protected, actions := protectTemplateActions(source)
+	src := []byte(protected)
+
+	doc := markdownConverter.Parser().Parse(text.NewReader(src))
+
+	var buf bytes.Buffer
+	if err := markdownConverter.Renderer().Render(&buf, src, doc); err != nil {
+		return "", "", err
+	}
+
+	htmlBody := actionOnlyParagraphPattern.ReplaceAllString(buf.String(), "$1\n")
+	htmlBody = restoreTemplateActions(htmlBody, actions)
+
+	w := &plainTextWriter{source: src, actions: actions}
+	w.blocks(doc, "")
+	textBody := blankLinesPattern.ReplaceAllString(w.buf.String(), "\n\n")
+	textBody = restoreTemplateActions(strings.TrimSpace(textBody), actions) + "\n"
+
+	return htmlBody, textBody, nil
+}
+
+// plainTextWriter renders a Markdown document as text meant to be read as is:
+// emphasis is dropped, links are followed by their address and headings are
+// underlined.
+type plainTextWriter struct {
+	buf     strings.Builder
+	source  []byte
+	actions []string
+}
+
+// line writes s with prefix in front of every line, which is how list and
+// quote nesting is kept.
+func (w *plainTextWriter) line(prefix, s string) {
+	for _, l := range strings.Split(s, "\n") {
+		w.buf.WriteString(strings.TrimRight(prefix+l, " "))
+		w.buf.WriteString("\n")
+	}
+}
+
+func (w *plainTextWriter) blocks(parent ast.Node, prefix string) {
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		w.block(node, prefix)
+		if node.NextSibling() != nil {
+			w.line(prefix, "")
+		}
+	}
+}
+
+func (w *plainTextWriter) block(node ast.Node, prefix string) {
+	switch n := node.(type) {
+	case *ast.Heading:
+		heading := w.inlines(n)
+		w.line(prefix, heading)
+		if n.Level <= 2 {
+			underline := "="
+			if n.Level == 2 {
+				underline = "-"
+			}
+			width := utf8.RuneCountInString(restoreTemplateActions(heading, w.actions))
+			w.line(prefix, strings.Repeat(underline, max(width, 3)))
+		}
+	case *ast.Paragraph, *ast.TextBlock:
+		w.line(prefix, w.inlines(n))
+	case *ast.Blockquote:
+		w.blocks(n, prefix+"> ")
+	case *ast.List:
+		number := n.Start
+		for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+			marker := "- "
+			if n.IsOrdered() {
+				marker = strconv.Itoa(number) + ". "
+				number++
+			}
+
+			var itemWriter plainTextWriter
+			itemWriter.source = w.source
+			itemWriter.actions = w.actions
+			if n.IsTight {
+				for child := item.FirstChild(); child != nil; child = child.NextSibling() {
+					itemWriter.block(child, "")
+				}
+			} else {
+				itemWriter.blocks(item, "")
+			}
+
+			lines := strings.Split(strings.TrimRight(itemWriter.buf.String(), "\n"), "\n")
+			indent := strings.Repeat(" ", len(marker))
+			for i, l := range lines {
+				if i == 0 {
+					w.line(prefix, marker+l)
+				} else {
+					w.line(prefix+indent, l)
+				}
+			}
+			if !n.IsTight && item.NextSibling() != nil {
+				w.line(prefix, "")
+			}
+		}
+	case *ast.FencedCodeBlock, *ast.CodeBlock:
+		w.line(prefix+"    ", strings.TrimRight(w.lines(n), "\n"))
+	case *ast.HTMLBlock:
+		stripped := htmlTagPattern.ReplaceAllString(w.lines(n), "")
+		if trimmed := strings.TrimSpace(stripped); len(trimmed) > 0 {
+			w.line(prefix, trimmed)
+		}
+	case *ast.ThematicBreak:
+		w.line(prefix, "----")
+	default:
+		w.blocks(n, prefix)
+	}
+}
+
+func (w *plainTextWriter) lines(node ast.Node) string {
+	var b strings.Builder
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		b.Write(segment.Value(w.source))
+	}
+	return b.String()
+}
+
+func (w *plainTextWriter) inlines(parent ast.Node) string {
+	var b strings.Builder
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		switch n := node.(type) {
+		case *ast.Text:
+			value := util.UnescapePunctuations(n.Value(w.source))
+			value = util.ResolveNumericReferences(value)
+			b.Write(util.ResolveEntityNames(value))
+			if n.HardLineBreak() || n.SoftLineBreak() {
+				b.WriteString("\n")
+			}
+		case *ast.String:
+			b.Write(n.Value)
+		case *ast.Link:
+			label := w.inlines(n)
+			destination := string(n.Destination)
+			b.WriteString(label)
+			if label != destination {
+				b.WriteString(" (" + destination + ")")
+			}
+		case *ast.AutoLink:
+			b.Write(n.URL(w.source))
+		case *ast.Image:
+			if alt := w.inlines(n); len(alt) > 0 {
+				b.WriteString("[" + alt + "]")
+			}
+		case *ast.RawHTML:
+			// Inline tags such as <br> carry no text of their own.
+		default:
+			b.WriteString(w.inlines(n))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,230 @@
+//
+// Email Partial Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+var (
+	ErrEmailPartialInUse    = errors.New("email partial is in use")
+	ErrEmailPartialNotFound = errors.New("email partial not found")
+)
+
+// emailPartialUsage lists the emails and partials that include the partial
+// with the given slug, or use it as their layout.
+func (a *appLayer) emailPartialUsage(slug string) ([]string, error) {
+	users := []string{}
+
+	emails, err := a.store.GetAllEmails()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, email := range *emails {
+		if (email.LayoutSlug != nil && *email.LayoutSlug == slug) ||
+			slices.Contains(findTemplateCalls(email.HtmlBody), slug) ||
+			slices.Contains(findTemplateCalls(email.TextBody), slug) {
+			users = append(users, "email \""+email.Name+"\"")
+		}
+	}
+
+	partials, err := a.store.GetAllEmailPartials()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, partial := range *partials {
+		if partial.Slug != slug &&
+			(slices.Contains(findTemplateCalls(partial.HtmlBody), slug) ||
+				slices.Contains(findTemplateCalls(partial.TextBody), slug)) {
+			users = append(users, "partial \""+partial.Name+"\"")
+		}
+	}
+
+	return users, nil
+}
+
+// validateEmailPartial parses and test-renders a partial before it is saved.
+// Layouts must include the email with {{template "content" .}}.
+func (a *appLayer) validateEmailPartial(id uint, name, slug string, layout bool, htmlBody, textBody string) error {
+	problems := &ValidationError{}
+
+	if len(strings.TrimSpace(name)) == 0 {
+		problems.add("name", "name is required")
+	}
+
+	if len(strings.TrimSpace(slug)) == 0 {
+		problems.add("slug", "slug is required")
+	} else if reservedTemplateNames[slug] {
+		problems.add("slug", "\""+slug+"\" is reserved")
+	} else if existing, err := a.store.GetEmailPartialWithSlug(slug); err == nil && existing.ID != id {
+		problems.add("slug", "another partial already uses slug \""+slug+"\"")
+	}
+
+	if id != 0 {
+		existing, err := a.store.GetEmailPartial(id)
+		if err != nil {
+			return ErrEmailPartialNotFound
+		}
+
+		if existing.Slug != slug || existing.Layout != layout {
+			users, err := a.emailPartialUsage(existing.Slug)
+			if err != nil {
+				return err
+			}
+
+			if len(users) > 0 {
+				problems.add("slug", "slug and layout cannot change while used by "+strings.Join(users, ", "))
+			}
+		}
+	}
+
+	if layout && !slices.Contains(findTemplateCalls(htmlBody), contentTemplateName) {
+		problems.add("htmlBody", "a layout must include {{template \"content\" .}}")
+	}
+
+	if layout && len(strings.TrimSpace(textBody)) > 0 && !slices.Contains(findTemplateCalls(textBody), contentTemplateName) {
+		problems.add("textBody", "a layout must include {{template \"content\" .}}")
+	}
+
+	// Render against sample data with the other partials available and an
+	// empty email body, so mistakes show up here rather than in every email.
+	set, err := a.loadEmailTemplateSet(a.store, nil)
+	if err != nil {
+		return err
+	}
+
+	sample := emailTemplateData{
+		Form:      &store.Form{Name: "Sample form", Slug: "sample-form"},
+		Values:    map[string]string{},
+		Reference: previewReference,
+	}
+
+	var buf bytes.Buffer
+	if tmpl, err := htmltemplate.New("htmlBody").Funcs(htmltemplate.FuncMap(emailTemplateFuncs(set.Location))).Parse(htmlBody); err != nil {
+		addTemplateError(problems, "htmlBody", err)
+	} else {
+		for _, partial := range set.Partials {
+			if partial.Slug != slug {
+				_, _ = tmpl.New(partial.Slug).Parse(partial.HtmlBody)
+			}
+		}
+		_, _ = tmpl.New(contentTemplateName).Parse("")
+		if err := tmpl.ExecuteTemplate(&buf, "htmlBody", sample); err != nil {
+			addTemplateError(problems, "htmlBody", err)
+		}
+	}
+
+	buf.Reset()
+	if tmpl, err := template.New("textBody").Funcs(emailTemplateFuncs(set.Location)).Parse(textBody); err != nil {
+		addTemplateError(problems, "textBody", err)
+	} else {
+		for _, partial := range set.Partials {
+			if partial.Slug != slug {
+				_, _ = tmpl.New(partial.Slug).Parse(partial.TextBody)
+			}
+		}
+		_, _ = tmpl.New(contentTemplateName).Parse("")
+		if err := tmpl.ExecuteTemplate(&buf, "textBody", sample); err != nil {
+			addTemplateError(problems, "textBody", err)
+		}
+	}
+
+	return problems.errOrNil()
+}
+
+func (a *appLayer) CreateEmailPartial(user *models.UserInternal, name, slug string, layout bool, htmlBody, textBody string) (*models.EmailPartialInternal, error) {
+	if err := a.validateEmailPartial(0, name, slug, layout, htmlBody, textBody); err != nil {
+		return nil, err
+	}
+
+	partial, err := a.store.CreateEmailPartial(user.Username, name, slug, layout, htmlBody, textBody)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.EmailPartialInternal{}
+	internal.Internalize(partial)
+	return &internal, nil
+}
+
+func (a *appLayer) DeleteEmailPartial(id uint) error {
+	partial, err := a.store.GetEmailPartial(id)
+	if err != nil {
+		return ErrEmailPartialNotFound
+	}
+
+	users, err := a.emailPartialUsage(partial.Slug)
+	if err != nil {
+		return err
+	}
+
+	if len(users) > 0 {
+		return ErrEmailPartialInUse
+	}
+
+	return a.store.DeleteEmailPartial(id)
+}
+
+func (a *appLayer) GetAllEmailPartials() (*[]models.EmailPartialInternal, error) {
+	partials, err := a.store.GetAllEmailPartials()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.EmailPartialInternal, len(*partials))
+	for i := range *partials {
+		result[i].Internalize(&(*partials)[i])
+	}
+	return &result, nil
+}
+
+func (a *appLayer) GetEmailPartial(id uint) (*models.EmailPartialInternal, error) {
+	partial, err := a.store.GetEmailPartial(id)
+	if err != nil {
+		return nil, ErrEmailPartialNotFound
+	}
+
+	internal := models.EmailPartialInternal{}
+	internal.Internalize(partial)
+	return &internal, nil
+}
+
+func (a *appLayer) UpdateEmailPartial(user *models.UserInternal, id uint, name, slug string, layout bool, htmlBody, textBody string) (*models.EmailPartialInternal, error) {
+	if err := a.validateEmailPartial(id, name, slug, layout, htmlBody, textBody); err != nil {
+		return nil, err
+	}
+
+	partial, err := a.store.UpdateEmailPartial(id, user.Username, name, slug, layout, htmlBody, textBody)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.EmailPartialInternal{}
+	internal.Internalize(partial)
+	return &internal, nil
+}
@@ -0,0 +1,257 @@
+//
+// Email Revision Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const (
+	diffDelete = "delete"
+	diffEqual  = "equal"
+	diffInsert = "insert"
+)
+
+var ErrEmailRevisionNotFound = errors.New("email revision not found")
+
+// diffLines compares two texts line by line using their longest common
+// subsequence. Every line of both texts appears in the result, marked as kept,
+// removed or added.
+func diffLines(from, to string) []models.EmailDiffLineInternal {
+	lines := []models.EmailDiffLineInternal{}
+	diffLineRange(strings.Split(from, "\n"), strings.Split(to, "\n"), &lines)
+	return lines
+}
+
+// diffLineRange appends the diff of a and b to lines. It splits the problem
+// in half the way Hirschberg's algorithm does, so memory stays linear in the
+// length of the texts rather than growing with their product, which matters
+// for large HTML templates.
+func diffLineRange(a, b []string, lines *[]models.EmailDiffLineInternal) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		*lines = append(*lines, models.EmailDiffLineInternal{Op: diffEqual, Text: a[prefix]})
+		prefix++
+	}
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		for _, line := range b {
+			*lines = append(*lines, models.EmailDiffLineInternal{Op: diffInsert, Text: line})
+		}
+	case len(b) == 0:
+		for _, line := range a {
+			*lines = append(*lines, models.EmailDiffLineInternal{Op: diffDelete, Text: line})
+		}
+	case len(a) == 1:
+		match := -1
+		for j, line := range b {
+			if line == a[0] {
+				match = j
+				break
+			}
+		}
+		if match < 0 {
+			*lines = append(*lines, models.EmailDiffLineInternal{Op: diffDelete, Text: a[0]})
+		}
+		for j, line := range b {
+			if j == match {
+				*lines = append(*lines, models.EmailDiffLineInternal{Op: diffEqual, Text: line})
+			} else {
+				*lines = append(*lines, models.EmailDiffLineInternal{Op: diffInsert, Text: line})
+			}
+		}
+	default:
+		// Split a in half and find the point in b where the longest common
+		// subsequences of the two halves meet.
+		mid := len(a) / 2
+		forward := commonPrefixLengths(a[:mid], b)
+		backward := commonSuffixLengths(a[mid:], b)
+
+		split := 0
+		for j := range forward {
+			if forward[j]+backward[j] > forward[split]+backward[split] {
+				split = j
+			}
+		}
+
+		diffLineRange(a[:mid], b[:split], lines)
+		diffLineRange(a[mid:], b[split:], lines)
+	}
+
+	for _, line := range common {
+		*lines = append(*lines, models.EmailDiffLineInternal{Op: diffEqual, Text: line})
+	}
+}
+
+// commonPrefixLengths returns, for each j, the length of the longest common
+// subsequence of a and b[:j], keeping only one row of the table at a time.
+func commonPrefixLengths(a, b []string) []int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				current[j+1] = previous[j] + 1
+			} else {
+				current[j+1] = max(previous[j+1], current[j])
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous
+}
+
+// commonSuffixLengths returns, for each j, the length of the longest common
+// subsequence of a and b[j:].
+func commonSuffixLengths(a, b []string) []int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				current[j] = previous[j+1] + 1
+			} else {
+				current[j] = max(previous[j], current[j+1])
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous
+}
+
+func (a *appLayer) getEmailRevision(emailId, revision uint) (*store.EmailRevision, error) {
+	if _, err := a.store.GetEmail(emailId); err != nil {
+		return nil, ErrEmailNotFound
+	}
+
+	emailRevision, err := a.store.GetEmailRevision(emailId, revision)
+	if err != nil {
+		return nil, ErrEmailRevisionNotFound
+	}
+
+	return emailRevision, nil
+}
+
+// DiffEmailRevisions compares two revisions of an email. Only the parts that
+// changed are listed.
+func (a *appLayer) DiffEmailRevisions(id, from, to uint) (*models.EmailRevisionDiffInternal, error) {
+	fromRevision, err := a.getEmailRevision(id, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toRevision, err := a.getEmailRevision(id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	optional := func(value *string) string {
+		if value == nil {
+			return ""
+		}
+		return *value
+	}
+
+	parts := []struct {
+		field    string
+		from, to string
+	}{
+		{"name", fromRevision.Name, toRevision.Name},
+		{"slug", fromRevision.Slug, toRevision.Slug},
+		{"layoutSlug", optional(fromRevision.LayoutSlug), optional(toRevision.LayoutSlug)},
+		{"subject", fromRevision.Subject, toRevision.Subject},
+		{"markdownBody", optional(fromRevision.MarkdownBody), optional(toRevision.MarkdownBody)},
+		{"htmlBody", fromRevision.HtmlBody, toRevision.HtmlBody},
+		{"textBody", fromRevision.TextBody, toRevision.TextBody},
+	}
+
+	diff := models.EmailRevisionDiffInternal{
+		From:   from,
+		To:     to,
+		Fields: []models.EmailFieldDiffInternal{},
+	}
+	for _, part := range parts {
+		if part.from != part.to {
+			diff.Fields = append(diff.Fields, models.EmailFieldDiffInternal{
+				Field: part.field,
+				Lines: diffLines(part.from, part.to),
+			})
+		}
+	}
+
+	return &diff, nil
+}
+
+func (a *appLayer) GetEmailRevision(id, revision uint) (*models.EmailRevisionInternal, error) {
+	emailRevision, err := a.getEmailRevision(id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.EmailRevisionInternal{}
+	internal.Internalize(emailRevision)
+	return &internal, nil
+}
+
+func (a *appLayer) GetEmailRevisions(id uint) (*[]models.EmailRevisionInternal, error) {
+	if _, err := a.store.GetEmail(id); err != nil {
+		return nil, ErrEmailNotFound
+	}
+
+	revisions, err := a.store.GetEmailRevisions(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.EmailRevisionInternal, len(*revisions))
+	for i := range *revisions {
+		result[i].Internalize(&(*revisions)[i])
+	}
+	return &result, nil
+}
+
+// RestoreEmailRevision saves the layout, subject and bodies of an earlier
+// revision as a new revision. The name and slug are left alone since forms
+// refer to the email by slug. The restored template is validated like any
+// other edit, as partials and form fields may have changed since.
+func (a *appLayer) RestoreEmailRevision(user *models.UserInternal, id, revision uint) (*models.EmailInternal, error) {
+	emailRevision, err := a.getEmailRevision(id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := a.store.GetEmail(id)
+	if err != nil {
+		return nil, ErrEmailNotFound
+	}
+
+	return a.UpdateEmail(user, id, email.Name, email.Slug, emailRevision.LayoutSlug, emailRevision.Subject, emailRevision.MarkdownBody, emailRevision.HtmlBody, emailRevision.TextBody)
+}
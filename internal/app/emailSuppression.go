@@ -0,0 +1,174 @@
+//
+// Email Suppression Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"html"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+var (
+	ErrBulkEmailNotConfigured   = errors.New("bulk email needs a public url and unsubscribe secret")
+	ErrEmailSuppressionNotFound = errors.New("email suppression not found")
+	ErrInvalidUnsubscribeToken  = errors.New("invalid unsubscribe token")
+)
+
+func normalizeEmailAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if parsed, err := mail.ParseAddress(address); err == nil {
+		address = parsed.Address
+	}
+
+	return strings.ToLower(address)
+}
+
+// bulkEmailConfigured reports whether unsubscribe links can be made. Bulk
+// email is not sent without them.
+func (a *appLayer) bulkEmailConfigured() bool {
+	return len(a.config.PublicURL) > 0 && len(a.config.UnsubscribeSecret) > 0
+}
+
+// unsubscribeToken signs an address so unsubscribe links cannot be forged for
+// someone else's address.
+func (a *appLayer) unsubscribeToken(address string) string {
+	mac := hmac.New(sha256.New, []byte(a.config.UnsubscribeSecret))
+	mac.Write([]byte(normalizeEmailAddress(address)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// unsubscribeURL is the one-click link placed in bulk email for address.
+func (a *appLayer) unsubscribeURL(address string) string {
+	query := url.Values{}
+	query.Set("address", normalizeEmailAddress(address))
+	query.Set("token", a.unsubscribeToken(address))
+
+	return strings.TrimRight(a.config.PublicURL, "/") + "/api/v1/unsubscribe?" + query.Encode()
+}
+
+// unsubscribeHeaders are the RFC 2369 and RFC 8058 headers that let mail
+// clients offer their own unsubscribe button.
+func (a *appLayer) unsubscribeHeaders(address string) map[string]string {
+	return map[string]string{
+		"List-Unsubscribe":      "<" + a.unsubscribeURL(address) + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+}
+
+// addUnsubscribeFooter appends an unsubscribe link to bulk email whose
+// template does not already place {{.UnsubscribeURL}} itself.
+func addUnsubscribeFooter(rendered *renderedEmail, unsubscribeURL string) {
+	escaped := html.EscapeString(unsubscribeURL)
+	if !strings.Contains(rendered.HtmlBody, unsubscribeURL) && !strings.Contains(rendered.HtmlBody, escaped) {
+		rendered.HtmlBody += `<p style="font-size:12px;color:#666666"><a href="` + escaped + `">Unsubscribe</a> from these emails.</p>`
+	}
+
+	if !strings.Contains(rendered.TextBody, unsubscribeURL) {
+		rendered.TextBody += "\n\nUnsubscribe from these emails: " + unsubscribeURL + "\n"
+	}
+}
+
+// suppressedAddresses returns which of the addresses are on the suppression
+// list, keyed by their normalized form.
+func suppressedAddresses(s store.StoreLayer, addresses []string) (map[string]bool, error) {
+	normalized := make([]string, len(addresses))
+	for i, address := range addresses {
+		normalized[i] = normalizeEmailAddress(address)
+	}
+
+	suppressions, err := s.GetEmailSuppressionsForAddresses(normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]bool{}
+	for _, suppression := range *suppressions {
+		result[suppression.Address] = true
+	}
+
+	return result, nil
+}
+
+func (a *appLayer) CreateEmailSuppression(address string) (*models.EmailSuppressionInternal, error) {
+	problems := &ValidationError{}
+	if _, err := mail.ParseAddress(strings.TrimSpace(address)); err != nil {
+		problems.add("address", "not a valid email address")
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	suppression, err := a.store.CreateEmailSuppression(normalizeEmailAddress(address), store.SuppressionReasonManual)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.EmailSuppressionInternal{}
+	internal.Internalize(suppression)
+	return &internal, nil
+}
+
+func (a *appLayer) DeleteEmailSuppression(id uint) error {
+	if _, err := a.store.GetEmailSuppression(id); err != nil {
+		return ErrEmailSuppressionNotFound
+	}
+
+	return a.store.DeleteEmailSuppression(id)
+}
+
+func (a *appLayer) GetAllEmailSuppressions() (*[]models.EmailSuppressionInternal, error) {
+	suppressions, err := a.store.GetAllEmailSuppressions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.EmailSuppressionInternal, len(*suppressions))
+	for i := range *suppressions {
+		result[i].Internalize(&(*suppressions)[i])
+	}
+	return &result, nil
+}
+
+// Unsubscribe adds the address to the suppression list after checking the
+// token from the link it was sent.
+func (a *appLayer) Unsubscribe(address, token string) error {
+	expected := a.unsubscribeToken(address)
+	if !a.bulkEmailConfigured() || len(address) == 0 || !hmac.Equal([]byte(expected), []byte(token)) {
+		return ErrInvalidUnsubscribeToken
+	}
+
+	if _, err := a.store.CreateEmailSuppression(normalizeEmailAddress(address), store.SuppressionReasonUnsubscribe); err != nil {
+		slog.Error("Unable to record unsubscribe",
+			"layer", "app",
+			"entity", "emailSuppression",
+			"error", err,
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,332 @@
+//
+// Email Template Checks
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const (
+	// contentTemplateName is what a layout calls to include the email body.
+	contentTemplateName = "content"
+	layoutTemplateName  = "layout"
+)
+
+// reservedTemplateNames cannot be used as partial slugs because the parts of
+// an email are assembled under these names.
+var reservedTemplateNames = map[string]bool{
+	contentTemplateName: true,
+	layoutTemplateName:  true,
+	"subject":           true,
+	"htmlBody":          true,
+	"textBody":          true,
+}
+
+var templateErrorPattern = regexp.MustCompile(`(?s)^(?:html/)?template: ?([^:]*):(\d+):(?:\d+:)?\s*(.*)$`)
+
+// addTemplateError records a parse or execution error, pulling the line number
+// out of the message when the template package included one. Errors raised
+// inside a layout or partial name it, since their line numbers do not refer to
+// the part being checked.
+func addTemplateError(problems *ValidationError, part string, err error) {
+	matches := templateErrorPattern.FindStringSubmatch(err.Error())
+	if matches == nil {
+		problems.add(part, err.Error())
+		return
+	}
+
+	line, _ := strconv.Atoi(matches[2])
+	switch matches[1] {
+	case part, contentTemplateName:
+		problems.addAt(part, line, matches[3])
+	case layoutTemplateName:
+		problems.add(part, "layout line "+matches[2]+": "+matches[3])
+	default:
+		problems.add(part, "partial \""+matches[1]+"\" line "+matches[2]+": "+matches[3])
+	}
+}
+
+type renderedEmail struct {
+	Subject  string
+	HtmlBody string
+	TextBody string
+}
+
+// emailTemplateSet is what an email is assembled with besides its own parts:
+// the shared partials, the layout it names, if any, and the timezone times are
+// shown in.
+type emailTemplateSet struct {
+	Layout   *store.EmailPartial
+	Location *time.Location
+	Partials []store.EmailPartial
+}
+
+func (a *appLayer) loadEmailTemplateSet(s store.StoreLayer, layoutSlug *string) (*emailTemplateSet, error) {
+	partials, err := s.GetAllEmailPartials()
+	if err != nil {
+		return nil, err
+	}
+
+	set := &emailTemplateSet{Location: a.location}
+	for i := range *partials {
+		partial := (*partials)[i]
+		if !partial.Layout {
+			set.Partials = append(set.Partials, partial)
+		} else if layoutSlug != nil && partial.Slug == *layoutSlug {
+			set.Layout = &partial
+		}
+	}
+
+	return set, nil
+}
+
+// assembleHtmlTemplate parses the body, the partials and the layout into one
+// set and returns it with the name of the template to execute.
+func assembleHtmlTemplate(part, body string, set *emailTemplateSet) (*htmltemplate.Template, string, error) {
+	tmpl, err := htmltemplate.New(part).Funcs(htmltemplate.FuncMap(emailTemplateFuncs(set.Location))).Parse(body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, partial := range set.Partials {
+		if _, err := tmpl.New(partial.Slug).Parse(partial.HtmlBody); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if set.Layout == nil || len(strings.TrimSpace(set.Layout.HtmlBody)) == 0 {
+		return tmpl, part, nil
+	}
+
+	if _, err := tmpl.New(contentTemplateName).Parse(`{{template "` + part + `" .}}`); err != nil {
+		return nil, "", err
+	}
+	if _, err := tmpl.New(layoutTemplateName).Parse(set.Layout.HtmlBody); err != nil {
+		return nil, "", err
+	}
+
+	return tmpl, layoutTemplateName, nil
+}
+
+// assembleTextTemplate is assembleHtmlTemplate for the plain-text part.
+func assembleTextTemplate(part, body string, set *emailTemplateSet) (*template.Template, string, error) {
+	tmpl, err := template.New(part).Funcs(emailTemplateFuncs(set.Location)).Parse(body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, partial := range set.Partials {
+		if _, err := tmpl.New(partial.Slug).Parse(partial.TextBody); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if set.Layout == nil || len(strings.TrimSpace(set.Layout.TextBody)) == 0 {
+		return tmpl, part, nil
+	}
+
+	if _, err := tmpl.New(contentTemplateName).Parse(`{{template "` + part + `" .}}`); err != nil {
+		return nil, "", err
+	}
+	if _, err := tmpl.New(layoutTemplateName).Parse(set.Layout.TextBody); err != nil {
+		return nil, "", err
+	}
+
+	return tmpl, layoutTemplateName, nil
+}
+
+// renderEmail renders all three parts of the template. Every part is rendered
+// even when an earlier one fails so that all problems are reported together.
+// A nil set renders the email on its own.
+func renderEmail(email *models.EmailInternal, set *emailTemplateSet, data interface{}) (*renderedEmail, error) {
+	if set == nil {
+		set = &emailTemplateSet{}
+	}
+
+	problems := &ValidationError{}
+	rendered := renderedEmail{}
+
+	if isSet(email.LayoutSlug) && set.Layout == nil {
+		problems.add("layoutSlug", "no layout with slug \""+*email.LayoutSlug+"\"")
+	}
+
+	var buf bytes.Buffer
+	if tmpl, err := template.New("subject").Funcs(emailTemplateFuncs(set.Location)).Parse(email.Subject); err != nil {
+		addTemplateError(problems, "subject", err)
+	} else if err := tmpl.Execute(&buf, data); err != nil {
+		addTemplateError(problems, "subject", err)
+	}
+	rendered.Subject = buf.String()
+
+	buf.Reset()
+	if tmpl, name, err := assembleHtmlTemplate("htmlBody", email.HtmlBody, set); err != nil {
+		addTemplateError(problems, "htmlBody", err)
+	} else if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		addTemplateError(problems, "htmlBody", err)
+	}
+	rendered.HtmlBody = buf.String()
+
+	buf.Reset()
+	if tmpl, name, err := assembleTextTemplate("textBody", email.TextBody, set); err != nil {
+		addTemplateError(problems, "textBody", err)
+	} else if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		addTemplateError(problems, "textBody", err)
+	}
+	rendered.TextBody = buf.String()
+
+	return &rendered, problems.errOrNil()
+}
+
+type valueReference struct {
+	Slug string
+	Line int
+}
+
+// walkTemplate calls visit for every node in the parsed template. atRoot is
+// false inside range and with blocks, where dot no longer points at the
+// template data.
+func walkTemplate(tree *parse.Tree, visit func(node parse.Node, atRoot bool)) {
+	if tree == nil || tree.Root == nil {
+		return
+	}
+
+	var walk func(node parse.Node, atRoot bool)
+	walk = func(node parse.Node, atRoot bool) {
+		visit(node, atRoot)
+
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child, atRoot)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe, atRoot)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd, atRoot)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg, atRoot)
+			}
+		case *parse.ChainNode:
+			walk(n.Node, atRoot)
+		case *parse.IfNode:
+			walk(n.Pipe, atRoot)
+			walk(n.List, atRoot)
+			walk(n.ElseList, atRoot)
+		case *parse.RangeNode:
+			walk(n.Pipe, atRoot)
+			walk(n.List, false)
+			walk(n.ElseList, atRoot)
+		case *parse.WithNode:
+			walk(n.Pipe, atRoot)
+			walk(n.List, false)
+			walk(n.ElseList, atRoot)
+		case *parse.TemplateNode:
+			walk(n.Pipe, atRoot)
+		}
+	}
+
+	walk(tree.Root, true)
+}
+
+// findValueReferences lists the submission values a template reads, either as
+// .Values.slug, $.Values.slug or index .Values "slug". References inside range
+// and with blocks only count when they go through $.
+func findValueReferences(text string) ([]valueReference, error) {
+	tmpl, err := template.New("").Funcs(emailTemplateFuncs(nil)).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []valueReference{}
+	add := func(slug string, node parse.Node) {
+		refs = append(refs, valueReference{
+			Slug: slug,
+			Line: 1 + strings.Count(text[:int(node.Position())], "\n"),
+		})
+	}
+
+	isValues := func(node parse.Node, atRoot bool) bool {
+		switch n := node.(type) {
+		case *parse.FieldNode:
+			return atRoot && len(n.Ident) == 1 && n.Ident[0] == "Values"
+		case *parse.VariableNode:
+			return len(n.Ident) == 2 && n.Ident[0] == "$" && n.Ident[1] == "Values"
+		}
+		return false
+	}
+
+	walkTemplate(tmpl.Tree, func(node parse.Node, atRoot bool) {
+		switch n := node.(type) {
+		case *parse.CommandNode:
+			if len(n.Args) == 3 {
+				if ident, ok := n.Args[0].(*parse.IdentifierNode); ok && ident.Ident == "index" && isValues(n.Args[1], atRoot) {
+					if str, ok := n.Args[2].(*parse.StringNode); ok {
+						add(str.Text, str)
+					}
+				}
+			}
+		case *parse.FieldNode:
+			if atRoot && len(n.Ident) >= 2 && n.Ident[0] == "Values" {
+				add(n.Ident[1], n)
+			}
+		case *parse.VariableNode:
+			if len(n.Ident) >= 3 && n.Ident[0] == "$" && n.Ident[1] == "Values" {
+				add(n.Ident[2], n)
+			}
+		}
+	})
+
+	return refs, nil
+}
+
+// findTemplateCalls lists the names of the templates a template includes with
+// {{template "name"}}. Text that does not parse calls nothing.
+func findTemplateCalls(text string) []string {
+	tmpl, err := template.New("").Funcs(emailTemplateFuncs(nil)).Parse(text)
+	if err != nil {
+		return nil
+	}
+
+	names := []string{}
+	walkTemplate(tmpl.Tree, func(node parse.Node, atRoot bool) {
+		if n, ok := node.(*parse.TemplateNode); ok {
+			names = append(names, n.Name)
+		}
+	})
+
+	return names
+}
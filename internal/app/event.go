@@ -0,0 +1,300 @@
+//
+// Event Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"errors"
+	"mime"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+// EventDateSourceDatabase marks feed events that come from events managed
+// here rather than from the RSS feed.
+const EventDateSourceDatabase = "database"
+
+var ErrEventNotFound = errors.New("event not found")
+
+func (a *appLayer) CreateEvent(user *models.UserInternal, title string, startsOn int64, endsOn *int64, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories []string) (*models.EventInternal, error) {
+	startsAt, endsAt, err := a.validateEvent(0, title, startsOn, endsOn, locationId, imageAssetId, formId, link)
+	if err != nil {
+		return nil, err
+	}
+
+	var event *store.Event
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
+		var err error
+		if event, err = tx.CreateEvent(user.Username, strings.TrimSpace(title), startsAt, endsAt, locationId, imageAssetId, description, formId, strings.TrimSpace(link), published, strings.Join(normalizeEventCategories(categories), "\n")); err != nil {
+			return err
+		}
+
+		return syncFormEvent(tx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventInternal := models.EventInternal{}
+	eventInternal.Internalize(event)
+
+	return &eventInternal, nil
+}
+
+func (a *appLayer) DeleteEvent(id uint) error {
+	if _, err := a.store.GetEvent(id); err != nil {
+		return ErrEventNotFound
+	}
+
+	return a.store.DeleteEvent(id)
+}
+
+func (a *appLayer) GetAllEvents() (*[]models.EventInternal, error) {
+	events, err := a.store.GetAllEvents()
+	if err != nil {
+		return &[]models.EventInternal{}, err
+	}
+
+	eventsInternal := make([]models.EventInternal, len(*events))
+	for i := range *events {
+		eventsInternal[i].Internalize(&(*events)[i])
+	}
+
+	return &eventsInternal, nil
+}
+
+func (a *appLayer) GetEvent(id uint) (*models.EventInternal, error) {
+	event, err := a.store.GetEvent(id)
+	if err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	eventInternal := models.EventInternal{}
+	eventInternal.Internalize(event)
+
+	return &eventInternal, nil
+}
+
+func (a *appLayer) UpdateEvent(user *models.UserInternal, id uint, title string, startsOn int64, endsOn *int64, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories []string) (*models.EventInternal, error) {
+	if _, err := a.store.GetEvent(id); err != nil {
+		return nil, ErrEventNotFound
+	}
+
+	startsAt, endsAt, err := a.validateEvent(id, title, startsOn, endsOn, locationId, imageAssetId, formId, link)
+	if err != nil {
+		return nil, err
+	}
+
+	var event *store.Event
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
+		var err error
+		if event, err = tx.UpdateEvent(id, user.Username, strings.TrimSpace(title), startsAt, endsAt, locationId, imageAssetId, description, formId, strings.TrimSpace(link), published, strings.Join(normalizeEventCategories(categories), "\n")); err != nil {
+			return err
+		}
+
+		return syncFormEvent(tx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventInternal := models.EventInternal{}
+	eventInternal.Internalize(event)
+
+	return &eventInternal, nil
+}
+
+// syncFormEvent copies an event's time and location onto the form it links
+// to. The event is the source of truth for them; reminders and calendar
+// invites read the copy on the form.
+func syncFormEvent(tx store.StoreLayer, event *store.Event) error {
+	if event.FormID == nil {
+		return nil
+	}
+
+	form, err := tx.GetForm(*event.FormID)
+	if err != nil {
+		return err
+	}
+
+	startsAt := event.StartsAt
+	return tx.SetFormEvent(form.ID, &startsAt, event.EndsAt, event.LocationID, form.CalendarInvite)
+}
+
+// validateEvent checks an event and that the records it points at exist,
+// returning its start and end as times. A form can only belong to one event.
+func (a *appLayer) validateEvent(id uint, title string, startsOn int64, endsOn *int64, locationId, imageAssetId, formId *uint, link string) (time.Time, *time.Time, error) {
+	problems := &ValidationError{}
+
+	if len(strings.TrimSpace(title)) == 0 {
+		problems.add("title", "title is required")
+	}
+
+	var startsAt time.Time
+	if startsOn <= 0 {
+		problems.add("startsOn", "start is required")
+	} else {
+		startsAt = time.UnixMilli(startsOn)
+	}
+
+	var endsAt *time.Time
+	if endsOn != nil {
+		end := time.UnixMilli(*endsOn)
+		if startsOn > 0 && !end.After(startsAt) {
+			problems.add("endsOn", "end must be after the start")
+		}
+		endsAt = &end
+	}
+
+	if locationId != nil {
+		if _, err := a.store.GetLocation(*locationId); err != nil {
+			problems.add("locationId", "location does not exist")
+		}
+	}
+
+	if imageAssetId != nil {
+		if _, err := a.store.GetAsset(*imageAssetId); err != nil {
+			problems.add("imageAssetId", "image asset does not exist")
+		}
+	}
+
+	if formId != nil {
+		if _, err := a.store.GetForm(*formId); err != nil {
+			problems.add("formId", "form does not exist")
+		} else if linked, err := a.store.GetEventForForm(*formId); err == nil && linked.ID != id {
+			problems.add("formId", "form already belongs to event \""+linked.Title+"\"")
+		} else if err != nil && !store.IsNotFound(err) {
+			return time.Time{}, nil, err
+		}
+	}
+
+	if link = strings.TrimSpace(link); len(link) > 0 {
+		if u, err := url.Parse(link); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			problems.add("link", "link must be an http or https URL")
+		}
+	}
+
+	return startsAt, endsAt, problems.errOrNil()
+}
+
+// publishedFeedEvents converts published events into feed events so they can
+// be listed alongside the RSS feed.
+func (a *appLayer) publishedFeedEvents() ([]*models.FeedEventInternal, error) {
+	events, err := a.store.GetPublishedEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load every referenced image in one query rather than one per event,
+	// since this runs on each public feed request.
+	assetFileNames := map[uint]string{}
+	if len(a.config.AssetsURL) > 0 {
+		assetIds := []uint{}
+		for _, event := range *events {
+			if event.ImageAssetID != nil {
+				assetIds = append(assetIds, *event.ImageAssetID)
+			}
+		}
+
+		assets, err := a.store.GetAssets(assetIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, asset := range *assets {
+			assetFileNames[asset.ID] = asset.FileName
+		}
+	}
+
+	feedEvents := make([]*models.FeedEventInternal, 0, len(*events))
+	for i := range *events {
+		event := &(*events)[i]
+		eventInternal := models.EventInternal{}
+		eventInternal.Internalize(event)
+		local := event.StartsAt.In(a.location)
+		startsAt := event.StartsAt
+
+		feedEvent := &models.FeedEventInternal{
+			Title:            event.Title,
+			Link:             event.Link,
+			Description:      event.Description,
+			PubDate:          event.CreatedAt.UTC().Format(time.RFC1123Z),
+			EventDate:        time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC),
+			StartsAt:         &startsAt,
+			EndsAt:           event.EndsAt,
+			DateSource:       EventDateSourceDatabase,
+			GUID:             "event-" + strconv.FormatUint(uint64(event.ID), 10),
+			Categories:       eventInternal.Categories,
+			LocationID:       event.LocationID,
+			PlainDescription: event.Description,
+		}
+
+		if event.ImageAssetID != nil {
+			if fileName, ok := assetFileNames[*event.ImageAssetID]; ok {
+				feedEvent.MediaURL = strings.TrimRight(a.config.AssetsURL, "/") + "/q/" + url.PathEscape(fileName)
+				feedEvent.MediaType = mime.TypeByExtension(path.Ext(fileName))
+			}
+		}
+
+		feedEvents = append(feedEvents, feedEvent)
+	}
+
+	return feedEvents, nil
+}
+
+// mergeFeedEvents adds managed events to the RSS feed's events. A managed
+// event replaces a feed item with the same link, or one on the same day with
+// the same title, as it has the times and location the feed lacks. It keeps
+// the item's categories when it was given none of its own.
+func mergeFeedEvents(feedEvents, managed []*models.FeedEventInternal) []*models.FeedEventInternal {
+	links := make(map[string]*models.FeedEventInternal, len(managed))
+	titles := make(map[string]*models.FeedEventInternal, len(managed))
+	for _, event := range managed {
+		if link := normalizeEventLink(event.Link); len(link) > 0 {
+			links[link] = event
+		}
+		titles[eventDedupKey(event)] = event
+	}
+
+	merged := make([]*models.FeedEventInternal, 0, len(feedEvents)+len(managed))
+	for _, event := range feedEvents {
+		replacement := links[normalizeEventLink(event.Link)]
+		if replacement == nil {
+			replacement = titles[eventDedupKey(event)]
+		}
+		if replacement == nil {
+			merged = append(merged, event)
+		} else if len(replacement.Categories) == 0 {
+			replacement.Categories = event.Categories
+		}
+	}
+
+	return append(merged, managed...)
+}
+
+func normalizeEventLink(link string) string {
+	return strings.TrimRight(strings.TrimSpace(link), "/")
+}
+
+func eventDedupKey(event *models.FeedEventInternal) string {
+	return event.EventDate.Format("2006-01-02") + " " + strings.Join(strings.Fields(strings.ToLower(event.Title)), " ")
+}
@@ -0,0 +1,147 @@
+//
+// Event Category Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+)
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// EventFilter narrows events to a program, which is one of their categories,
+// and to a location, given by its ID or name. Categories and names match
+// ignoring case and punctuation, so "QTBIPOC Night" and "qtbipoc-night" are
+// the same program. Empty fields match every event.
+type EventFilter struct {
+	Category string
+	Location string
+}
+
+// GetEventPrograms lists the categories of the events from today to six
+// months ahead, with how many events each has, so partners can pick a feed.
+func (a *appLayer) GetEventPrograms() (*[]models.EventProgramInternal, error) {
+	feed, err := a.GetUpcomingEventsFeed(EventFilter{})
+	if err != nil {
+		return &[]models.EventProgramInternal{}, err
+	}
+
+	programs := []models.EventProgramInternal{}
+	bySlug := map[string]int{}
+	for _, event := range feed.Events {
+		for _, category := range event.Categories {
+			slug := slugify(category)
+			if len(slug) == 0 {
+				continue
+			}
+
+			i, ok := bySlug[slug]
+			if !ok {
+				i = len(programs)
+				bySlug[slug] = i
+				programs = append(programs, models.EventProgramInternal{Name: category, Slug: slug})
+			}
+			programs[i].Events++
+		}
+	}
+
+	sort.Slice(programs, func(i, j int) bool {
+		return programs[i].Slug < programs[j].Slug
+	})
+
+	if len(a.config.AssetsURL) > 0 {
+		base := strings.TrimRight(a.config.AssetsURL, "/") + "/programs/"
+		for i := range programs {
+			programs[i].FeedURL = base + programs[i].Slug
+			programs[i].CalendarURL = base + programs[i].Slug + ".ics"
+		}
+	}
+
+	return &programs, nil
+}
+
+func (f EventFilter) apply(events []*models.FeedEventInternal) []*models.FeedEventInternal {
+	category := slugify(f.Category)
+	location := strings.TrimSpace(f.Location)
+	if len(category) == 0 && len(location) == 0 {
+		return events
+	}
+
+	filtered := []*models.FeedEventInternal{}
+	for _, event := range events {
+		if len(category) > 0 && !eventInCategory(event, category) {
+			continue
+		}
+		if len(location) > 0 && !eventAtLocation(event, location) {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+
+	return filtered
+}
+
+func eventInCategory(event *models.FeedEventInternal, slug string) bool {
+	for _, category := range event.Categories {
+		if slugify(category) == slug {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eventAtLocation(event *models.FeedEventInternal, location string) bool {
+	if event.Location == nil {
+		return false
+	}
+
+	if id, err := strconv.ParseUint(location, 10, 32); err == nil {
+		return event.Location.ID == uint(id)
+	}
+
+	return slugify(event.Location.Name) == slugify(location)
+}
+
+// slugify lowercases a name and joins its words with hyphens, the form
+// category and location names take in feed URLs.
+func slugify(name string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// normalizeEventCategories tidies the categories given for an event, dropping
+// blank ones and ones that are the same program as an earlier one.
+func normalizeEventCategories(categories []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, category := range categories {
+		category = strings.Join(strings.Fields(category), " ")
+		slug := slugify(category)
+		if len(slug) == 0 || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		result = append(result, category)
+	}
+
+	return result
+}
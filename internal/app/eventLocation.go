@@ -0,0 +1,250 @@
+//
+// Event Location Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+)
+
+const (
+	EventLocationMatchOverride = "override"
+	EventLocationMatchEvent    = "event"
+	EventLocationMatchKeyword  = "keyword"
+	EventLocationMatchName     = "name"
+)
+
+var ErrEventLocationOverrideNotFound = errors.New("event location override not found")
+
+func (a *appLayer) DeleteEventLocationOverride(eventKey string) error {
+	overrides, err := a.store.GetAllEventLocationOverrides()
+	if err != nil {
+		return err
+	}
+
+	for _, override := range *overrides {
+		if override.EventKey == eventKey {
+			return a.store.DeleteEventLocationOverride(eventKey)
+		}
+	}
+
+	return ErrEventLocationOverrideNotFound
+}
+
+func (a *appLayer) GetEventLocationOverrides() (*[]models.EventLocationOverrideInternal, error) {
+	overrides, err := a.store.GetAllEventLocationOverrides()
+	if err != nil {
+		return &[]models.EventLocationOverrideInternal{}, err
+	}
+
+	overridesInternal := make([]models.EventLocationOverrideInternal, len(*overrides))
+	for i := range *overrides {
+		overridesInternal[i].Internalize(&(*overrides)[i])
+	}
+
+	return &overridesInternal, nil
+}
+
+// SetEventLocationOverride pins the event with the given GUID, or link, to a
+// location. A nil location pins it to none so nothing is matched.
+func (a *appLayer) SetEventLocationOverride(user *models.UserInternal, eventKey string, locationId *uint) (*models.EventLocationOverrideInternal, error) {
+	problems := &ValidationError{}
+	eventKey = strings.TrimSpace(eventKey)
+	if len(eventKey) == 0 {
+		problems.add("eventKey", "event key is required")
+	}
+	if locationId != nil {
+		if _, err := a.store.GetLocation(*locationId); err != nil {
+			problems.add("locationId", "location does not exist")
+		}
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	override, err := a.store.SetEventLocationOverride(user.Username, eventKey, locationId)
+	if err != nil {
+		return nil, err
+	}
+
+	overrideInternal := models.EventLocationOverrideInternal{}
+	overrideInternal.Internalize(override)
+
+	return &overrideInternal, nil
+}
+
+// eventKey identifies a feed event for overrides, its GUID or else its link.
+func eventKey(event *models.FeedEventInternal) string {
+	if len(event.GUID) > 0 {
+		return event.GUID
+	}
+
+	return event.Link
+}
+
+// assignEventLocations works out where each event is. An override wins, then
+// the location a managed event was given, then a location keyword in the
+// title or else the content, then a location named in the title.
+func (a *appLayer) assignEventLocations(events []*models.FeedEventInternal) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	locations, err := a.GetAllLocations()
+	if err != nil {
+		return err
+	}
+
+	overrides, err := a.store.GetAllEventLocationOverrides()
+	if err != nil {
+		return err
+	}
+
+	byId := make(map[uint]*models.LocationInternal, len(*locations))
+	for i := range *locations {
+		byId[(*locations)[i].ID] = &(*locations)[i]
+	}
+
+	pinned := make(map[string]*uint, len(*overrides))
+	for _, override := range *overrides {
+		pinned[override.EventKey] = override.LocationID
+	}
+
+	for _, event := range events {
+		event.Location, event.LocationMatch, event.LocationImageURL = nil, "", ""
+
+		if locationId, ok := pinned[eventKey(event)]; ok {
+			if locationId != nil {
+				event.Location = byId[*locationId]
+			}
+			if event.Location != nil {
+				event.LocationMatch = EventLocationMatchOverride
+			}
+		} else if event.LocationID != nil && byId[*event.LocationID] != nil {
+			event.Location, event.LocationMatch = byId[*event.LocationID], EventLocationMatchEvent
+		} else if location := matchLocationKeywords(event.Title, *locations); location != nil {
+			event.Location, event.LocationMatch = location, EventLocationMatchKeyword
+		} else if location := matchLocationKeywords(eventPlainText(event.Description+" "+event.ContentEncoded), *locations); location != nil {
+			event.Location, event.LocationMatch = location, EventLocationMatchKeyword
+		} else if location := matchLocationName(event.Title, *locations); location != nil {
+			event.Location, event.LocationMatch = location, EventLocationMatchName
+		}
+
+		if event.Location != nil {
+			event.LocationImageURL = a.publicURL(event.Location.BackgroundImagePath)
+		}
+	}
+
+	return nil
+}
+
+// matchLocationKeywords finds the location with a keyword in text, preferring
+// the longest keyword as it is the most specific.
+func matchLocationKeywords(text string, locations []models.LocationInternal) *models.LocationInternal {
+	text = strings.ToLower(text)
+
+	var match *models.LocationInternal
+	matchLength := 0
+	for i := range locations {
+		for _, keyword := range locations[i].Keywords {
+			keyword = strings.ToLower(keyword)
+			if len(keyword) > matchLength && containsWord(text, keyword) {
+				match = &locations[i]
+				matchLength = len(keyword)
+			}
+		}
+	}
+
+	return match
+}
+
+// matchLocationName finds the location named in an event title, preferring
+// the longest name so "Main Gym Annex" wins over "Main Gym".
+func matchLocationName(title string, locations []models.LocationInternal) *models.LocationInternal {
+	title = strings.ToLower(title)
+
+	var match *models.LocationInternal
+	matchLength := 0
+	for i := range locations {
+		name := strings.ToLower(strings.TrimSpace(locations[i].Name))
+		if len(name) > matchLength && containsWord(title, name) {
+			match = &locations[i]
+			matchLength = len(name)
+		}
+	}
+
+	return match
+}
+
+// containsWord reports whether word appears in text without being part of a
+// longer word, so "MBP" does not match "MBPX".
+func containsWord(text, word string) bool {
+	if len(word) == 0 {
+		return false
+	}
+
+	for offset := 0; offset < len(text); {
+		index := strings.Index(text[offset:], word)
+		if index < 0 {
+			return false
+		}
+
+		start := offset + index
+		end := start + len(word)
+		if !wordRuneBefore(text, start) && !wordRuneAfter(text, end) {
+			return true
+		}
+		offset = start + 1
+	}
+
+	return false
+}
+
+func wordRuneBefore(text string, index int) bool {
+	if index == 0 {
+		return false
+	}
+
+	r := []rune(text[:index])
+	return isWordRune(r[len(r)-1])
+}
+
+func wordRuneAfter(text string, index int) bool {
+	if index >= len(text) {
+		return false
+	}
+
+	return isWordRune([]rune(text[index:])[0])
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// publicURL makes a path served by this site, such as a location's background
+// image, absolute. Values that are already URLs are left alone.
+func (a *appLayer) publicURL(path string) string {
+	if len(path) == 0 || strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+
+	return strings.TrimRight(a.config.PublicURL, "/") + "/" + strings.TrimLeft(path, "/")
+}
@@ -18,14 +18,119 @@
 package app
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/OutClimb/OutClimb/internal/app/models"
 )
 
-func (a *appLayer) GetEventsForMonth(year int, month time.Month) (*models.EventFeedInternal, error) {
-	feed, err := a.store.GetAllEvents()
+const (
+	defaultEventsLimit = 20
+	maxEventsLimit     = 100
+)
+
+// EventQueryInput selects a page of events. From and To are dates and both
+// are inclusive.
+type EventQueryInput struct {
+	From   *time.Time
+	To     *time.Time
+	Filter EventFilter
+	Sort   string
+	Limit  int
+	Offset int
+}
+
+// GetEvents returns a page of events from the feed, oldest first unless Sort
+// is "desc".
+func (a *appLayer) GetEvents(query EventQueryInput) (*models.EventPageInternal, error) {
+	problems := &ValidationError{}
+	if query.Sort != "" && query.Sort != "asc" && query.Sort != "desc" {
+		problems.add("sort", "sort must be asc or desc")
+	}
+	if query.Limit < 0 || query.Limit > maxEventsLimit {
+		problems.add("limit", fmt.Sprintf("limit must be between 1 and %d", maxEventsLimit))
+	}
+	if query.Offset < 0 {
+		problems.add("offset", "offset must not be negative")
+	}
+	if query.From != nil && query.To != nil && query.To.Before(*query.From) {
+		problems.add("to", "to must not be before from")
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	var to *time.Time
+	if query.To != nil {
+		end := query.To.AddDate(0, 0, 1)
+		to = &end
+	}
+
+	feed, err := a.eventsBetween(query.From, to, query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Sort == "desc" {
+		for i, j := 0, len(feed.Events)-1; i < j; i, j = i+1, j-1 {
+			feed.Events[i], feed.Events[j] = feed.Events[j], feed.Events[i]
+		}
+	}
+
+	page := &models.EventPageInternal{
+		Total:  len(feed.Events),
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultEventsLimit
+	}
+
+	start := min(page.Offset, page.Total)
+	end := min(start+page.Limit, page.Total)
+	page.Events = feed.Events[start:end]
+	for _, event := range page.Events {
+		event.PlainDescription = eventPlainText(event.Description)
+	}
+
+	return page, nil
+}
+
+func (a *appLayer) GetEventsForMonth(year int, month time.Month, filter EventFilter) (*models.EventFeedInternal, error) {
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	return a.eventsBetween(&from, &to, filter)
+}
+
+// GetUpcomingEvents returns a page of events from today onwards, soonest
+// first.
+func (a *appLayer) GetUpcomingEvents(limit, offset int, filter EventFilter) (*models.EventPageInternal, error) {
+	today := a.eventsToday()
+
+	return a.GetEvents(EventQueryInput{From: &today, Filter: filter, Sort: "asc", Limit: limit, Offset: offset})
+}
+
+// GetUpcomingEventsFeed returns the events from today to six months ahead,
+// for feeds that are not tied to a month.
+func (a *appLayer) GetUpcomingEventsFeed(filter EventFilter) (*models.EventFeedInternal, error) {
+	today := a.eventsToday()
+	to := today.AddDate(0, eventsCalendarMonths, 0)
+
+	return a.eventsBetween(&today, &to, filter)
+}
+
+// eventsBetween returns the feed, with published events merged in, holding
+// only the events dated on or after from and before to that match the filter,
+// oldest first, each with its location worked out. Either bound may be nil.
+func (a *appLayer) eventsBetween(from, to *time.Time, filter EventFilter) (*models.EventFeedInternal, error) {
+	feed, err := a.store.GetEventFeed()
 	if err != nil {
 		return nil, err
 	}
@@ -33,17 +138,203 @@ func (a *appLayer) GetEventsForMonth(year int, month time.Month) (*models.EventF
 	feedInternal := &models.EventFeedInternal{}
 	feedInternal.Internalize(feed)
 
-	var filtered []*models.EventInternal
-	for _, event := range feedInternal.Events {
-		if event.EventDate.Year() == year && event.EventDate.Month() == month {
-			filtered = append(filtered, event)
+	managed, err := a.publishedFeedEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*models.FeedEventInternal
+	for _, event := range mergeFeedEvents(feedInternal.Events, managed) {
+		if from != nil && event.EventDate.Before(*from) {
+			continue
+		}
+		if to != nil && !event.EventDate.Before(*to) {
+			continue
 		}
+		filtered = append(filtered, event)
 	}
 
-	sort.Slice(filtered, func(i, j int) bool {
+	sort.SliceStable(filtered, func(i, j int) bool {
 		return filtered[i].EventDate.Before(filtered[j].EventDate)
 	})
 
-	feedInternal.Events = filtered
+	if err := a.assignEventLocations(filtered); err != nil {
+		return nil, err
+	}
+
+	feedInternal.Events = filter.apply(filtered)
 	return feedInternal, nil
 }
+
+// eventsToday is the current date in the configured timezone, expressed the
+// same way as event dates, which are midnight UTC.
+func (a *appLayer) eventsToday() time.Time {
+	now := time.Now().In(a.location)
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// GetEventDiagnostics reports how dates were found for the cached events feed
+// and which items were skipped.
+func (a *appLayer) GetEventDiagnostics() (*models.EventDiagnosticsInternal, error) {
+	feed, err := a.store.GetEventFeed()
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := &models.EventDiagnosticsInternal{}
+	diagnostics.Internalize(feed)
+	return diagnostics, nil
+}
+
+// RefreshEvents fetches the upstream events feed now rather than waiting for
+// the cached copy to expire.
+func (a *appLayer) RefreshEvents() (*models.EventFeedInternal, error) {
+	feed, err := a.store.RefreshEventFeed()
+	if err != nil {
+		return nil, err
+	}
+
+	feedInternal := &models.EventFeedInternal{}
+	feedInternal.Internalize(feed)
+	return feedInternal, nil
+}
+
+const (
+	eventsCalendarPastDays = 30
+	eventsCalendarMonths   = 6
+)
+
+var (
+	eventsHtmlTagPattern  = regexp.MustCompile(`<[^>]*>`)
+	eventsClockLayouts    = []string{"3:04PM", "3PM", "15:04"}
+	eventsClockNormalizer = strings.NewReplacer(" ", "", ".", "")
+)
+
+// GetEventsCalendarForMonth renders the events in a month as an iCalendar
+// feed.
+func (a *appLayer) GetEventsCalendarForMonth(year int, month time.Month, filter EventFilter) ([]byte, error) {
+	feed, err := a.GetEventsForMonth(year, month, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.eventsCalendar(feed.FetchedAt, feed.Events)
+}
+
+// GetUpcomingEventsCalendar renders a rolling window of events, from a month
+// ago to six months ahead, as an iCalendar feed for calendar subscriptions.
+func (a *appLayer) GetUpcomingEventsCalendar(filter EventFilter) ([]byte, error) {
+	today := a.eventsToday()
+	from := today.AddDate(0, 0, -eventsCalendarPastDays)
+	to := today.AddDate(0, eventsCalendarMonths, 0)
+
+	feed, err := a.eventsBetween(&from, &to, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.eventsCalendar(feed.FetchedAt, feed.Events)
+}
+
+// eventsCalendar converts feed events into VEVENTs. An event at a location
+// picks up that location's address and coordinates, and its usual times when
+// the feed gave no time of day. Events without either are all day events.
+func (a *appLayer) eventsCalendar(fetchedAt time.Time, events []*models.FeedEventInternal) ([]byte, error) {
+	domain := a.calendarDomain()
+	icalEvents := make([]icalEvent, 0, len(events))
+	for _, event := range events {
+		icalEvent := icalEvent{
+			UID:         eventUID(event, domain),
+			Summary:     event.Title,
+			Description: eventPlainText(event.Description),
+			URL:         event.Link,
+			Categories:  event.Categories,
+		}
+
+		location := event.Location
+		if location != nil {
+			icalEvent.Location = locationText(location.Name, location.Address)
+			icalEvent.Latitude = location.Latitude
+			icalEvent.Longitude = location.Longitude
+		}
+
+		if event.StartsAt != nil {
+			end := event.StartsAt.Add(calendarDefaultHours * time.Hour)
+			if event.EndsAt != nil {
+				end = *event.EndsAt
+			}
+			icalEvent.Start = *event.StartsAt
+			icalEvent.End = &end
+		} else if start, end, ok := a.eventTimes(event.EventDate, location); ok {
+			icalEvent.Start = start
+			icalEvent.End = &end
+		} else {
+			end := event.EventDate.AddDate(0, 0, 1)
+			icalEvent.Start = event.EventDate
+			icalEvent.End = &end
+			icalEvent.AllDay = true
+		}
+
+		icalEvents = append(icalEvents, icalEvent)
+	}
+
+	return writeICalendar("PUBLISH", fetchedAt, icalEvents), nil
+}
+
+// eventUID derives a stable UID from the feed GUID, falling back to the link
+// for items without one, so subscribers update events rather than duplicate
+// them.
+func eventUID(event *models.FeedEventInternal, domain string) string {
+	key := event.GUID
+	if len(key) == 0 {
+		key = event.Link
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return "event-" + hex.EncodeToString(sum[:16]) + "@" + domain
+}
+
+// eventPlainText strips the markup feed descriptions are written in.
+func eventPlainText(s string) string {
+	return strings.TrimSpace(html.UnescapeString(eventsHtmlTagPattern.ReplaceAllString(s, "")))
+}
+
+// eventTimes applies a location's usual start and end times to an event date.
+// It reports false when there is no location or its start time is not a
+// recognizable clock time.
+func (a *appLayer) eventTimes(date time.Time, location *models.LocationInternal) (time.Time, time.Time, bool) {
+	if location == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	startClock, ok := parseEventClock(location.StartTime)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, a.location)
+	start := day.Add(startClock)
+	end := start.Add(calendarDefaultHours * time.Hour)
+	if endClock, ok := parseEventClock(location.EndTime); ok {
+		end = day.Add(endClock)
+		if !end.After(start) {
+			end = end.AddDate(0, 0, 1)
+		}
+	}
+
+	return start, end, true
+}
+
+// parseEventClock reads times such as "6:30 PM", "6pm" or "18:30" as an
+// offset from midnight.
+func parseEventClock(value string) (time.Duration, bool) {
+	value = strings.ToUpper(eventsClockNormalizer.Replace(value))
+	for _, layout := range eventsClockLayouts {
+		if clock, err := time.Parse(layout, value); err == nil {
+			return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, true
+		}
+	}
+
+	return 0, false
+}
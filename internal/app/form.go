@@ -18,17 +18,21 @@
 package app
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/mail"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dlclark/regexp2"
 
 	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/mailer"
 	"github.com/OutClimb/OutClimb/internal/store"
 )
 
@@ -41,6 +45,14 @@ var (
 	ErrInvalidNotificationEmail = errors.New("invalid notification email address")
 	ErrMissingField             = errors.New("missing required field")
 	ErrForbidden                = errors.New("forbidden")
+	ErrSubmissionNotFound       = errors.New("submission not found")
+)
+
+const (
+	referencePrefix   = "OC-"
+	referenceAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	referenceLength   = 5
+	referenceAttempts = 10
 )
 
 type FormFieldInput struct {
@@ -53,10 +65,53 @@ type FormFieldInput struct {
 	Order      uint
 }
 
+// FormEventInput ties a form to the event it registers people for. Times are
+// milliseconds since the epoch, like the form's opening and closing times.
+type FormEventInput struct {
+	StartsOn       *int64
+	EndsOn         *int64
+	LocationID     *uint
+	CalendarInvite bool
+	Reminders      []FormReminderInput
+}
+
+// FormReminderInput is an email sent to every registrant OffsetMinutes from
+// the event start. Negative offsets are before the event.
+type FormReminderInput struct {
+	EmailSlug     string
+	OffsetMinutes int
+}
+
+var formFieldTypes = map[string]bool{
+	"given-name":  true,
+	"family-name": true,
+	"email":       true,
+	"phone":       true,
+	"text-input":  true,
+	"text-area":   true,
+	"checkboxes":  true,
+	"radios":      true,
+	"select":      true,
+	"bool":        true,
+}
+
+var formFieldTypesWithOptions = map[string]bool{
+	"checkboxes": true,
+	"radios":     true,
+	"select":     true,
+}
+
+type fieldMetadata struct {
+	Options []string `json:"options"`
+}
+
 type emailTemplateData struct {
-	Form   *store.Form
-	Fields []store.FormField
-	Values map[string]string
+	Form      *store.Form
+	Fields    []store.FormField
+	Values    map[string]string
+	Reference string
+	// UnsubscribeURL is only set for bulk email.
+	UnsubscribeURL string
 }
 
 func canViewSubmissions(user *models.UserInternal, form *models.FormInternal) bool {
@@ -80,6 +135,28 @@ func canViewSubmissions(user *models.UserInternal, form *models.FormInternal) bo
 	return false
 }
 
+// linkedFormEvent replaces the time and location in event with those of the
+// event the form is linked to, since the event is where they are edited.
+func (a *appLayer) linkedFormEvent(formId uint, event FormEventInput) (FormEventInput, error) {
+	linked, err := a.store.GetEventForForm(formId)
+	if store.IsNotFound(err) {
+		return event, nil
+	} else if err != nil {
+		return event, err
+	}
+
+	startsOn := linked.StartsAt.UnixMilli()
+	event.StartsOn = &startsOn
+	event.EndsOn = nil
+	if linked.EndsAt != nil {
+		endsOn := linked.EndsAt.UnixMilli()
+		event.EndsOn = &endsOn
+	}
+	event.LocationID = linked.LocationID
+
+	return event, nil
+}
+
 func millisToTime(ms *int64) *time.Time {
 	if ms == nil || *ms <= 0 {
 		return nil
@@ -130,56 +207,78 @@ func (a *appLayer) checkFormAvailability(form *store.Form) error {
 	return nil
 }
 
+// parseFieldOptions reads the list of selectable options out of a field's metadata.
+func parseFieldOptions(metadata *string) ([]string, error) {
+	if metadata == nil || len(*metadata) == 0 {
+		return nil, errors.New("metadata is required")
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal([]byte(*metadata), &raw); err != nil {
+		return nil, errors.New("metadata must be valid JSON")
+	}
+
+	if _, ok := raw.(map[string]interface{}); !ok {
+		return nil, errors.New("metadata must be a JSON object")
+	}
+
+	parsed := fieldMetadata{}
+	if err := json.Unmarshal([]byte(*metadata), &parsed); err != nil {
+		return nil, errors.New("metadata options must be a list of strings")
+	}
+
+	if len(parsed.Options) == 0 {
+		return nil, errors.New("metadata must contain at least one option")
+	}
+
+	return parsed.Options, nil
+}
+
+func compileFieldValidation(validation string) (*regexp2.Regexp, error) {
+	re, err := regexp2.Compile(validation, 0)
+	if err != nil {
+		return nil, err
+	}
+	re.MatchTimeout = 100 * time.Millisecond
+
+	return re, nil
+}
+
 func validateFieldValue(field store.FormField, val string) error {
 	if field.Required && len(val) == 0 {
 		return ErrMissingField
 	}
 
 	if field.Validation != nil && len(val) > 0 {
-		re, err := regexp2.Compile(*field.Validation, 0)
+		re, err := compileFieldValidation(*field.Validation)
 		if err != nil {
 			return ErrInvalidField
 		}
-		re.MatchTimeout = 100 * time.Millisecond
 		matched, err := re.MatchString(val)
 		if err != nil || !matched {
 			return ErrInvalidField
 		}
 	}
 
-	var metadata *interface{}
-	if field.Metadata != nil && len(*field.Metadata) > 0 {
-		err := json.Unmarshal([]byte(*field.Metadata), &metadata)
-		if err != nil {
-			metadata = nil
-		}
-	}
-
 	if field.Type == "checkboxes" && val != "" {
-		if metadata == nil || *metadata == nil {
-			return ErrInvalidField
-		}
-		options, ok := (*metadata).(map[string]interface{})
-		if !ok {
+		options, err := parseFieldOptions(field.Metadata)
+		if err != nil {
 			return ErrInvalidField
 		}
 		selectedOptions := strings.Split(val, ", ")
 		for _, selectedOption := range selectedOptions {
-			if _, ok := options[selectedOption]; !ok {
+			if !slices.Contains(options, selectedOption) {
 				return ErrInvalidField
 			}
 		}
 	}
 
 	if (field.Type == "radios" || field.Type == "select") && val != "" {
-		if metadata == nil || *metadata == nil {
-			return ErrInvalidField
-		}
-		options, ok := (*metadata).(map[string]interface{})
-		if !ok {
+		options, err := parseFieldOptions(field.Metadata)
+		if err != nil {
 			return ErrInvalidField
 		}
-		if _, ok := options[val]; !ok {
+		if !slices.Contains(options, val) {
 			return ErrInvalidField
 		}
 	}
@@ -216,8 +315,176 @@ func validateNotificationEmailTo(notificationEmailTo *string) error {
 	return nil
 }
 
-func (a *appLayer) CreateForm(user *models.UserInternal, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, fields []FormFieldInput) (*models.FormInternal, error) {
+func isSet(value *string) bool {
+	return value != nil && len(*value) > 0
+}
+
+func validateFormFieldInput(problems *ValidationError, index int, field FormFieldInput) {
+	prefix := fmt.Sprintf("fields[%d]", index)
+
+	if len(strings.TrimSpace(field.Name)) == 0 {
+		problems.add(prefix+".name", "name is required")
+	}
+
+	if len(strings.TrimSpace(field.Slug)) == 0 {
+		problems.add(prefix+".slug", "slug is required")
+	}
+
+	if !formFieldTypes[field.Type] {
+		problems.add(prefix+".type", "unknown field type \""+field.Type+"\"")
+	}
+
+	if isSet(field.Validation) {
+		if _, err := compileFieldValidation(*field.Validation); err != nil {
+			problems.add(prefix+".validation", "invalid regular expression: "+err.Error())
+		}
+	}
+
+	if formFieldTypesWithOptions[field.Type] {
+		options, err := parseFieldOptions(field.Metadata)
+		if err != nil {
+			problems.add(prefix+".metadata", err.Error())
+			return
+		}
+
+		seen := map[string]bool{}
+		for _, option := range options {
+			if len(strings.TrimSpace(option)) == 0 {
+				problems.add(prefix+".metadata", "options must not be empty")
+			} else if seen[option] {
+				problems.add(prefix+".metadata", "duplicate option \""+option+"\"")
+			} else if field.Type == "checkboxes" && strings.Contains(option, ", ") {
+				problems.add(prefix+".metadata", "checkbox option \""+option+"\" must not contain \", \"")
+			}
+			seen[option] = true
+		}
+	} else if isSet(field.Metadata) {
+		var raw interface{}
+		if err := json.Unmarshal([]byte(*field.Metadata), &raw); err != nil {
+			problems.add(prefix+".metadata", "metadata must be valid JSON")
+		} else if _, ok := raw.(map[string]interface{}); !ok {
+			problems.add(prefix+".metadata", "metadata must be a JSON object")
+		}
+	}
+}
+
+// validateFormDefinition checks a form and its fields before they are saved so
+// mistakes surface to the form author rather than to registrants.
+func (a *appLayer) validateFormDefinition(id uint, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, event FormEventInput, fields []FormFieldInput) error {
+	problems := &ValidationError{}
+
+	if len(strings.TrimSpace(name)) == 0 {
+		problems.add("name", "name is required")
+	}
+
+	if len(strings.TrimSpace(slug)) == 0 {
+		problems.add("slug", "slug is required")
+	} else if existing, err := a.store.GetFormWithSlug(slug); err == nil && existing.ID != id {
+		problems.add("slug", "another form already uses slug \""+slug+"\"")
+	} else if err != nil && !store.IsNotFound(err) {
+		return err
+	}
+
+	opens := millisToTime(opensOn)
+	closes := millisToTime(closesOn)
+	if opens != nil && closes != nil && !opens.Before(*closes) {
+		problems.add("closesOn", "form must close after it opens")
+	}
+
+	if maxSubmissions != nil && *maxSubmissions == 0 {
+		problems.add("maxSubmissions", "max submissions must be greater than zero")
+	}
+
+	fieldsBySlug := map[string]FormFieldInput{}
+	for i, field := range fields {
+		validateFormFieldInput(problems, i, field)
+
+		if _, ok := fieldsBySlug[field.Slug]; ok && len(field.Slug) > 0 {
+			problems.add(fmt.Sprintf("fields[%d].slug", i), "duplicate slug \""+field.Slug+"\"")
+		}
+		fieldsBySlug[field.Slug] = field
+	}
+
+	if isSet(confirmationEmailFieldSlug) {
+		if field, ok := fieldsBySlug[*confirmationEmailFieldSlug]; !ok {
+			problems.add("confirmationEmailFieldSlug", "no field with slug \""+*confirmationEmailFieldSlug+"\"")
+		} else if field.Type != "email" {
+			problems.add("confirmationEmailFieldSlug", "field \""+*confirmationEmailFieldSlug+"\" is not an email field")
+		}
+	}
+
+	if isSet(confirmationEmailSlug) {
+		if !isSet(confirmationEmailFieldSlug) {
+			problems.add("confirmationEmailFieldSlug", "a confirmation email requires an email field")
+		}
+		if _, err := a.store.GetEmailWithSlug(*confirmationEmailSlug); store.IsNotFound(err) {
+			problems.add("confirmationEmailSlug", "no email template with slug \""+*confirmationEmailSlug+"\"")
+		} else if err != nil {
+			return err
+		}
+	}
+
 	if err := validateNotificationEmailTo(notificationEmailTo); err != nil {
+		problems.add("notificationEmailTo", err.Error())
+	}
+
+	if isSet(notificationEmailSlug) {
+		if !isSet(notificationEmailTo) {
+			problems.add("notificationEmailTo", "a notification email requires at least one recipient")
+		}
+		if _, err := a.store.GetEmailWithSlug(*notificationEmailSlug); store.IsNotFound(err) {
+			problems.add("notificationEmailSlug", "no email template with slug \""+*notificationEmailSlug+"\"")
+		} else if err != nil {
+			return err
+		}
+	}
+
+	eventStarts := millisToTime(event.StartsOn)
+	eventEnds := millisToTime(event.EndsOn)
+	if eventStarts == nil && (eventEnds != nil || event.LocationID != nil) {
+		problems.add("eventStartsOn", "an event needs a start time")
+	}
+	if eventStarts != nil && eventEnds != nil && !eventStarts.Before(*eventEnds) {
+		problems.add("eventEndsOn", "event must end after it starts")
+	}
+
+	if event.LocationID != nil {
+		if _, err := a.store.GetLocation(*event.LocationID); store.IsNotFound(err) {
+			problems.add("eventLocationId", "no location with id "+strconv.FormatUint(uint64(*event.LocationID), 10))
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if event.CalendarInvite {
+		if eventStarts == nil {
+			problems.add("calendarInvite", "a calendar invite requires an event start time")
+		}
+		if !isSet(confirmationEmailSlug) {
+			problems.add("calendarInvite", "a calendar invite is sent with the confirmation email, which this form does not have")
+		}
+	}
+
+	for i, reminder := range event.Reminders {
+		field := fmt.Sprintf("reminders[%d]", i)
+		if eventStarts == nil {
+			problems.add(field, "a reminder requires an event start time")
+		}
+		if !isSet(confirmationEmailFieldSlug) {
+			problems.add(field, "reminders are sent to the confirmation email field, which this form does not have")
+		}
+		if _, err := a.store.GetEmailWithSlug(reminder.EmailSlug); store.IsNotFound(err) {
+			problems.add(field+".emailSlug", "no email template with slug \""+reminder.EmailSlug+"\"")
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return problems.errOrNil()
+}
+
+func (a *appLayer) CreateForm(user *models.UserInternal, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, event FormEventInput, fields []FormFieldInput) (*models.FormInternal, error) {
+	if err := a.validateFormDefinition(0, name, slug, opensOn, closesOn, maxSubmissions, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug, event, fields); err != nil {
 		return nil, err
 	}
 
@@ -236,6 +503,14 @@ func (a *appLayer) CreateForm(user *models.UserInternal, name, slug string, open
 			}
 		}
 
+		if err := tx.SetFormEvent(form.ID, millisToTime(event.StartsOn), millisToTime(event.EndsOn), event.LocationID, event.CalendarInvite); err != nil {
+			return err
+		}
+
+		if err := tx.SetFormReminders(form.ID, formReminders(event.Reminders)); err != nil {
+			return err
+		}
+
 		return tx.SetFormViewableBy(form.ID, viewableBy)
 	})
 
@@ -251,12 +526,17 @@ func (a *appLayer) CreateForm(user *models.UserInternal, name, slug string, open
 	return a.loadFormInternal(formId)
 }
 
-func (a *appLayer) UpdateForm(user *models.UserInternal, id uint, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, fields []FormFieldInput) (*models.FormInternal, error) {
-	if err := validateNotificationEmailTo(notificationEmailTo); err != nil {
+func (a *appLayer) UpdateForm(user *models.UserInternal, id uint, name, slug string, opensOn, closesOn *int64, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string, viewableBy []uint, event FormEventInput, fields []FormFieldInput) (*models.FormInternal, error) {
+	event, err := a.linkedFormEvent(id, event)
+	if err != nil {
 		return nil, err
 	}
 
-	err := a.store.WithTransaction(func(tx store.StoreLayer) error {
+	if err := a.validateFormDefinition(id, name, slug, opensOn, closesOn, maxSubmissions, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug, event, fields); err != nil {
+		return nil, err
+	}
+
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
 		form, err := tx.UpdateForm(id, user.Username, name, slug, millisToTime(opensOn), millisToTime(closesOn), maxSubmissions, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug)
 		if err != nil {
 			return err
@@ -292,6 +572,14 @@ func (a *appLayer) UpdateForm(user *models.UserInternal, id uint, name, slug str
 			}
 		}
 
+		if err := tx.SetFormEvent(form.ID, millisToTime(event.StartsOn), millisToTime(event.EndsOn), event.LocationID, event.CalendarInvite); err != nil {
+			return err
+		}
+
+		if err := tx.SetFormReminders(form.ID, formReminders(event.Reminders)); err != nil {
+			return err
+		}
+
 		return tx.SetFormViewableBy(form.ID, viewableBy)
 	})
 
@@ -326,6 +614,10 @@ func (a *appLayer) DeleteForm(user *models.UserInternal, id uint) error {
 			return err
 		}
 
+		if err := tx.SetFormReminders(id, nil); err != nil {
+			return err
+		}
+
 		return tx.DeleteForm(id)
 	})
 
@@ -399,12 +691,98 @@ func (a *appLayer) GetAllForms() (*[]models.FormInternal, error) {
 	return &result, nil
 }
 
-func (a *appLayer) CreateSubmission(slug string, values map[string]string) (*models.SubmissionInternal, error) {
+// generateReference creates a short code registrants can quote back to us,
+// using Crockford's alphabet so it survives being read aloud or retyped.
+func generateReference() (string, error) {
+	buf := make([]byte, referenceLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, referenceLength)
+	for i, b := range buf {
+		code[i] = referenceAlphabet[int(b)%len(referenceAlphabet)]
+	}
+
+	return referencePrefix + string(code), nil
+}
+
+// normalizeReference accepts references the way people tend to type them,
+// in lower case or without the prefix.
+func normalizeReference(reference string) string {
+	reference = strings.ToUpper(strings.TrimSpace(reference))
+	if !strings.HasPrefix(reference, referencePrefix) {
+		reference = referencePrefix + reference
+	}
+	return reference
+}
+
+func (a *appLayer) uniqueReference() (string, error) {
+	for i := 0; i < referenceAttempts; i++ {
+		reference, err := generateReference()
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := a.store.GetSubmissionWithReference(reference); err != nil {
+			if store.IsNotFound(err) {
+				return reference, nil
+			}
+			return "", err
+		}
+	}
+
+	return "", errors.New("unable to generate a unique reference")
+}
+
+// submissionValueMap keys a submission's values by field slug, the shape
+// email templates see them in.
+func submissionValueMap(values *[]store.SubmissionValue, fieldSlugByID map[uint]string) map[string]string {
+	result := map[string]string{}
+	for _, v := range *values {
+		result[fieldSlugByID[v.FormFieldID]] = v.Value
+	}
+	return result
+}
+
+func (a *appLayer) loadSubmissionInternal(submission *store.Submission) (*models.SubmissionInternal, error) {
+	fields, err := a.store.GetAllFormFieldsForForm(submission.FormID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldSlugByID := map[uint]string{}
+	for _, f := range *fields {
+		fieldSlugByID[f.ID] = f.Slug
+	}
+
+	values, err := a.store.GetAllSubmissionValueForSubmission(submission.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	internal := models.SubmissionInternal{}
+	internal.Internalize(submission, values, fieldSlugByID)
+
+	return &internal, nil
+}
+
+func (a *appLayer) CreateSubmission(slug string, values map[string]string, idempotencyKey string) (*models.SubmissionInternal, error) {
 	form, err := a.store.GetFormWithSlug(slug)
 	if err != nil {
 		return nil, ErrFormNotFound
 	}
 
+	// A retried request gets the original result rather than a second registration.
+	if len(idempotencyKey) > 0 {
+		existing, err := a.store.GetSubmissionWithIdempotencyKey(form.ID, idempotencyKey)
+		if err == nil {
+			return a.loadSubmissionInternal(existing)
+		} else if !store.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
 	if err := a.checkFormAvailability(form); err != nil {
 		return nil, err
 	}
@@ -428,41 +806,76 @@ func (a *appLayer) CreateSubmission(slug string, values map[string]string) (*mod
 		}
 	}
 
-	submission, err := a.store.CreateSubmission(form.ID)
+	reference, err := a.uniqueReference()
 	if err != nil {
-		slog.Error("Unable to create submission", "layer", "app", "entity", "form", "formId", form.ID, "error", err)
+		slog.Error("Unable to generate submission reference", "layer", "app", "entity", "form", "formId", form.ID, "error", err)
 		return nil, err
 	}
 
-	for slug, val := range values {
-		field, ok := fieldBySlug[slug]
-		if !ok {
-			continue
+	var key *string
+	if len(idempotencyKey) > 0 {
+		key = &idempotencyKey
+	}
+
+	emailData := emailTemplateData{
+		Form:      form,
+		Fields:    *fields,
+		Values:    values,
+		Reference: reference,
+	}
+
+	var submission *store.Submission
+	var storedValues *[]store.SubmissionValue
+	err = a.store.WithTransaction(func(tx store.StoreLayer) error {
+		var err error
+		submission, err = tx.CreateSubmission(form.ID, reference, key)
+		if err != nil {
+			return err
 		}
-		if _, err := a.store.CreateSubmissionValue(submission.ID, field.ID, val); err != nil {
-			slog.Error("Unable to create submission value", "layer", "app", "entity", "form", "submissionId", submission.ID, "error", err)
-			return nil, err
+
+		for slug, val := range values {
+			field, ok := fieldBySlug[slug]
+			if !ok {
+				continue
+			}
+			if _, err := tx.CreateSubmissionValue(submission.ID, field.ID, val); err != nil {
+				slog.Error("Unable to create submission value", "layer", "app", "entity", "form", "submissionId", submission.ID, "error", err)
+				return err
+			}
 		}
-	}
 
-	storedValues, err := a.store.GetAllSubmissionValueForSubmission(submission.ID)
+		storedValues, err = tx.GetAllSubmissionValueForSubmission(submission.ID)
+		if err != nil {
+			return err
+		}
+
+		// Emails are queued in the same transaction so a registration is never
+		// saved without its confirmation, and vice versa.
+		return a.enqueueSubmissionEmails(tx, form, submission.ID, values, emailData)
+	})
 	if err != nil {
+		// A concurrent request with the same key may have won the race.
+		if key != nil {
+			if existing, lookupErr := a.store.GetSubmissionWithIdempotencyKey(form.ID, *key); lookupErr == nil {
+				return a.loadSubmissionInternal(existing)
+			}
+		}
+
+		slog.Error("Unable to create submission", "layer", "app", "entity", "form", "formId", form.ID, "error", err)
 		return nil, err
 	}
 
 	submissionInternal := models.SubmissionInternal{}
 	submissionInternal.Internalize(submission, storedValues, fieldSlugByID)
 
-	emailData := emailTemplateData{
-		Form:   form,
-		Fields: *fields,
-		Values: values,
-	}
+	return &submissionInternal, nil
+}
 
+func (a *appLayer) enqueueSubmissionEmails(tx store.StoreLayer, form *store.Form, submissionId uint, values map[string]string, emailData emailTemplateData) error {
 	if form.ConfirmationEmailSlug != nil && form.ConfirmationEmailFieldSlug != nil {
 		toAddress := values[*form.ConfirmationEmailFieldSlug]
 		if toAddress != "" {
-			confirmationEmail, err := a.store.GetEmailWithSlug(*form.ConfirmationEmailSlug)
+			confirmationEmail, err := tx.GetEmailWithSlug(*form.ConfirmationEmailSlug)
 			if err != nil {
 				slog.Error("Unable to get confirmation email template",
 					"layer", "app",
@@ -474,20 +887,33 @@ func (a *appLayer) CreateSubmission(slug string, values map[string]string) (*mod
 				emailInternal := models.EmailInternal{}
 				emailInternal.Internalize(confirmationEmail)
 
-				if err := a.sendEmail([]string{toAddress}, &emailInternal, emailData); err != nil {
-					slog.Error("Unable to send confirmation email",
+				var attachments []mailer.Attachment
+				if invite, err := a.formCalendarInvite(tx, form); err != nil {
+					slog.Error("Unable to build calendar invite",
+						"layer", "app",
+						"entity", "form",
+						"formId", form.ID,
+						"error", err,
+					)
+				} else if invite != nil {
+					attachments = append(attachments, *invite)
+				}
+
+				if err := a.enqueueEmail(tx, &form.ID, &submissionId, []string{toAddress}, &emailInternal, emailData, attachments); err != nil {
+					slog.Error("Unable to queue confirmation email",
 						"layer", "app",
 						"entity", "form",
 						"formId", form.ID,
 						"error", err,
 					)
+					return err
 				}
 			}
 		}
 	}
 
 	if form.NotificationEmailSlug != nil && form.NotificationEmailTo != nil && *form.NotificationEmailTo != "" {
-		notificationEmail, err := a.store.GetEmailWithSlug(*form.NotificationEmailSlug)
+		notificationEmail, err := tx.GetEmailWithSlug(*form.NotificationEmailSlug)
 		if err != nil {
 			slog.Error("Unable to get notification email template",
 				"layer", "app",
@@ -505,18 +931,19 @@ func (a *appLayer) CreateSubmission(slug string, values map[string]string) (*mod
 			emailInternal := models.EmailInternal{}
 			emailInternal.Internalize(notificationEmail)
 
-			if err := a.sendEmail(toAddresses, &emailInternal, emailData); err != nil {
-				slog.Error("Unable to send notification email",
+			if err := a.enqueueEmail(tx, &form.ID, &submissionId, toAddresses, &emailInternal, emailData, nil); err != nil {
+				slog.Error("Unable to queue notification email",
 					"layer", "app",
 					"entity", "form",
 					"formId", form.ID,
 					"error", err,
 				)
+				return err
 			}
 		}
 	}
 
-	return &submissionInternal, nil
+	return nil
 }
 
 func (a *appLayer) GetSubmissionsForForm(user *models.UserInternal, formId uint) (*[]models.SubmissionInternal, error) {
@@ -556,6 +983,44 @@ func (a *appLayer) GetSubmissionsForForm(user *models.UserInternal, formId uint)
 	return &result, nil
 }
 
+func (a *appLayer) GetSubmissionByReference(user *models.UserInternal, reference string) (*models.SubmissionInternal, error) {
+	submission, err := a.store.GetSubmissionWithReference(normalizeReference(reference))
+	if err != nil {
+		return nil, ErrSubmissionNotFound
+	}
+
+	formInternal, err := a.loadFormInternal(submission.FormID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canViewSubmissions(user, formInternal) {
+		return nil, ErrForbidden
+	}
+
+	return a.loadSubmissionInternal(submission)
+}
+
+// GetEmailsForSubmission lists every email queued for a submission, for
+// checking whether a registrant's confirmation actually went out.
+func (a *appLayer) GetEmailsForSubmission(user *models.UserInternal, submissionId uint) (*[]models.OutboundEmailInternal, error) {
+	submission, err := a.store.GetSubmission(submissionId)
+	if err != nil {
+		return nil, ErrSubmissionNotFound
+	}
+
+	formInternal, err := a.loadFormInternal(submission.FormID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canViewSubmissions(user, formInternal) {
+		return nil, ErrForbidden
+	}
+
+	return a.getOutboundEmails("", nil, &submission.ID, "")
+}
+
 func (a *appLayer) DeleteSubmission(user *models.UserInternal, submissionId uint) error {
 	if err := a.store.DeleteSubmissionValuesForSubmission(submissionId); err != nil {
 		slog.Error("Unable to delete submission values", "layer", "app", "entity", "form", "submissionId", submissionId, "error", err)
@@ -0,0 +1,186 @@
+//
+// Form Reminder Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const (
+	defaultReminderWorkerInterval = time.Minute
+
+	// reminderSendWindow is how late a reminder may still go out, so adding a
+	// reminder to an event that is long over does not email everyone.
+	reminderSendWindow = 24 * time.Hour
+)
+
+func formReminders(inputs []FormReminderInput) []store.FormReminder {
+	reminders := make([]store.FormReminder, len(inputs))
+	for i, input := range inputs {
+		reminders[i] = store.FormReminder{
+			EmailSlug:     input.EmailSlug,
+			OffsetMinutes: input.OffsetMinutes,
+		}
+	}
+	return reminders
+}
+
+// reminderDue reports whether the reminder should be going out now. Reminders
+// before an event stop once it has started.
+func reminderDue(reminder *store.FormReminder, eventStartsAt, now time.Time) bool {
+	sendAt := reminder.SendAt(eventStartsAt)
+	if now.Before(sendAt) || !now.Before(sendAt.Add(reminderSendWindow)) {
+		return false
+	}
+
+	return reminder.OffsetMinutes >= 0 || now.Before(eventStartsAt)
+}
+
+// RunReminderWorker queues due event reminders until ctx is cancelled.
+func (a *appLayer) RunReminderWorker(ctx context.Context) {
+	if !a.bulkEmailConfigured() {
+		slog.Warn("No public url or unsubscribe secret configured, form reminders are not sent",
+			"layer", "app",
+			"entity", "formReminder",
+		)
+		return
+	}
+
+	interval := parseDurationOrDefault(a.config.ReminderWorkerInterval, defaultReminderWorkerInterval, "reminder worker interval")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		a.processFormReminders(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *appLayer) processFormReminders(now time.Time) {
+	reminders, err := a.store.GetAllFormReminders()
+	if err != nil {
+		slog.Error("Unable to get form reminders",
+			"layer", "app",
+			"entity", "formReminder",
+			"error", err,
+		)
+		return
+	}
+
+	for i := range *reminders {
+		reminder := &(*reminders)[i]
+
+		form, err := a.store.GetForm(reminder.FormID)
+		if err != nil || form.EventStartsAt == nil || !isSet(form.ConfirmationEmailFieldSlug) {
+			continue
+		}
+
+		if !reminderDue(reminder, *form.EventStartsAt, now) {
+			continue
+		}
+
+		if err := a.sendFormReminder(form, reminder); err != nil {
+			slog.Error("Unable to send form reminder",
+				"layer", "app",
+				"entity", "formReminder",
+				"id", reminder.ID,
+				"formId", form.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// sendFormReminder queues the reminder for every submission it has not gone
+// to yet. Each one is claimed and queued in a single transaction, so a restart
+// partway through neither skips nor repeats anyone. Reminders are bulk email,
+// so anyone who has unsubscribed is skipped.
+//
+// Submissions have no status, so there is no cancelled or waitlisted state to
+// skip: every submission still on the form is reminded. Deleting a submission
+// is the only way to take someone off the list.
+func (a *appLayer) sendFormReminder(form *store.Form, reminder *store.FormReminder) error {
+	submissions, err := a.store.GetSubmissionsAwaitingReminder(reminder.ID, form.ID)
+	if err != nil || len(*submissions) == 0 {
+		return err
+	}
+
+	email, err := a.store.GetEmailWithSlug(reminder.EmailSlug)
+	if err != nil {
+		return err
+	}
+
+	emailInternal := models.EmailInternal{}
+	emailInternal.Internalize(email)
+
+	fields, err := a.store.GetAllFormFieldsForForm(form.ID)
+	if err != nil {
+		return err
+	}
+
+	fieldSlugByID := map[uint]string{}
+	for _, f := range *fields {
+		fieldSlugByID[f.ID] = f.Slug
+	}
+
+	for _, submission := range *submissions {
+		err := a.store.WithTransaction(func(tx store.StoreLayer) error {
+			claimed, err := tx.ClaimFormReminderSend(reminder.ID, submission.ID)
+			if err != nil || !claimed {
+				return err
+			}
+
+			storedValues, err := tx.GetAllSubmissionValueForSubmission(submission.ID)
+			if err != nil {
+				return err
+			}
+
+			values := submissionValueMap(storedValues, fieldSlugByID)
+			address := strings.TrimSpace(values[*form.ConfirmationEmailFieldSlug])
+			if len(address) == 0 {
+				return nil
+			}
+
+			data := emailTemplateData{
+				Form:      form,
+				Fields:    *fields,
+				Values:    values,
+				Reference: submission.Reference,
+			}
+
+			return a.enqueueBulkEmail(tx, form.ID, submission.ID, address, &emailInternal, data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
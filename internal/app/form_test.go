@@ -0,0 +1,187 @@
+//
+// Form Logic Tests
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+	"gorm.io/gorm"
+)
+
+// formDefinitionStore answers the lookups validateFormDefinition makes. An
+// err, when set, is returned by every lookup as if the database were down.
+type formDefinitionStore struct {
+	store.StoreLayer
+
+	formIdsBySlug map[string]uint
+	emailSlugs    map[string]bool
+	linkedEvents  map[uint]*store.Event
+	err           error
+}
+
+func (s *formDefinitionStore) GetEmailWithSlug(slug string) (*store.Email, error) {
+	if s.err != nil {
+		return &store.Email{}, s.err
+	}
+	if !s.emailSlugs[slug] {
+		return &store.Email{}, gorm.ErrRecordNotFound
+	}
+	return &store.Email{Slug: slug}, nil
+}
+
+func (s *formDefinitionStore) GetEventForForm(formId uint) (*store.Event, error) {
+	if s.err != nil {
+		return &store.Event{}, s.err
+	}
+	event, ok := s.linkedEvents[formId]
+	if !ok {
+		return &store.Event{}, gorm.ErrRecordNotFound
+	}
+	return event, nil
+}
+
+func (s *formDefinitionStore) GetFormWithSlug(slug string) (*store.Form, error) {
+	if s.err != nil {
+		return &store.Form{}, s.err
+	}
+	id, ok := s.formIdsBySlug[slug]
+	if !ok {
+		return &store.Form{}, gorm.ErrRecordNotFound
+	}
+	form := store.Form{Slug: slug}
+	form.ID = id
+	return &form, nil
+}
+
+func (s *formDefinitionStore) GetLocation(id uint) (*store.Location, error) {
+	if s.err != nil {
+		return &store.Location{}, s.err
+	}
+	return &store.Location{}, gorm.ErrRecordNotFound
+}
+
+func newFormDefinitionStore() *formDefinitionStore {
+	return &formDefinitionStore{
+		formIdsBySlug: map[string]uint{"taken": 2},
+		emailSlugs:    map[string]bool{"confirmation": true},
+		linkedEvents:  map[uint]*store.Event{},
+	}
+}
+
+func validFormFields() []FormFieldInput {
+	return []FormFieldInput{
+		{Name: "Name", Slug: "name", Type: "given-name", Required: true, Order: 1},
+		{Name: "Email", Slug: "email", Type: "email", Required: true, Order: 2},
+	}
+}
+
+func validationFields(err error) map[string]bool {
+	fields := map[string]bool{}
+	var problems *ValidationError
+	if errors.As(err, &problems) {
+		for _, problem := range problems.Problems {
+			fields[problem.Field] = true
+		}
+	}
+	return fields
+}
+
+func strPtr(value string) *string {
+	return &value
+}
+
+func TestValidateFormDefinitionAcceptsValidForm(t *testing.T) {
+	a := &appLayer{store: newFormDefinitionStore()}
+
+	err := a.validateFormDefinition(0, "Climb Night", "climb-night", nil, nil, nil, strPtr("email"), strPtr("confirmation"), nil, nil, FormEventInput{}, validFormFields())
+	if err != nil {
+		t.Fatalf("expected a valid form, got %v", err)
+	}
+}
+
+func TestValidateFormDefinitionAllowsKeepingOwnSlug(t *testing.T) {
+	a := &appLayer{store: newFormDefinitionStore()}
+
+	if err := a.validateFormDefinition(2, "Climb Night", "taken", nil, nil, nil, nil, nil, nil, nil, FormEventInput{}, validFormFields()); err != nil {
+		t.Fatalf("a form should keep its own slug, got %v", err)
+	}
+}
+
+func TestValidateFormDefinitionCollectsEveryProblem(t *testing.T) {
+	a := &appLayer{store: newFormDefinitionStore()}
+
+	opensOn, closesOn := int64(2000), int64(1000)
+	maxSubmissions := uint(0)
+	fields := append(validFormFields(), FormFieldInput{Name: "Again", Slug: "name", Type: "unknown"})
+
+	err := a.validateFormDefinition(1, " ", "taken", &opensOn, &closesOn, &maxSubmissions, strPtr("name"), strPtr("missing"), nil, nil, FormEventInput{}, fields)
+
+	got := validationFields(err)
+	for _, field := range []string{"name", "slug", "closesOn", "maxSubmissions", "fields[2].slug", "fields[2].type", "confirmationEmailFieldSlug", "confirmationEmailSlug"} {
+		if !got[field] {
+			t.Errorf("expected a problem with %s, got %v", field, err)
+		}
+	}
+}
+
+func TestValidateFormDefinitionReturnsLookupErrors(t *testing.T) {
+	s := newFormDefinitionStore()
+	s.err = errors.New("connection refused")
+	a := &appLayer{store: s}
+
+	err := a.validateFormDefinition(0, "Climb Night", "climb-night", nil, nil, nil, strPtr("email"), strPtr("confirmation"), nil, nil, FormEventInput{}, validFormFields())
+
+	var problems *ValidationError
+	if !errors.Is(err, s.err) || errors.As(err, &problems) {
+		t.Fatalf("expected the lookup error rather than a validation error, got %v", err)
+	}
+}
+
+func TestLinkedFormEventUsesTheEventTimeAndLocation(t *testing.T) {
+	s := newFormDefinitionStore()
+	startsAt := time.UnixMilli(1_800_000_000_000)
+	locationId := uint(4)
+	s.linkedEvents[2] = &store.Event{Title: "Climb Night", StartsAt: startsAt, LocationID: &locationId}
+	a := &appLayer{store: s}
+
+	endsOn := startsAt.UnixMilli() + int64(time.Hour/time.Millisecond)
+	event, err := a.linkedFormEvent(2, FormEventInput{EndsOn: &endsOn, CalendarInvite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.StartsOn == nil || *event.StartsOn != startsAt.UnixMilli() {
+		t.Errorf("expected the event start, got %v", event.StartsOn)
+	}
+	if event.EndsOn != nil {
+		t.Errorf("expected no end like the event, got %v", *event.EndsOn)
+	}
+	if event.LocationID == nil || *event.LocationID != locationId {
+		t.Errorf("expected the event location, got %v", event.LocationID)
+	}
+	if !event.CalendarInvite {
+		t.Error("expected the form's own settings to be kept")
+	}
+
+	unlinked, err := a.linkedFormEvent(3, FormEventInput{EndsOn: &endsOn})
+	if err != nil || unlinked.EndsOn != &endsOn {
+		t.Errorf("expected an unlinked form to keep its input, got %v, %v", unlinked, err)
+	}
+}
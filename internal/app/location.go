@@ -23,12 +23,12 @@ import (
 	"github.com/OutClimb/OutClimb/internal/app/models"
 )
 
-func (a *appLayer) CreateLocation(user *models.UserInternal, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*models.LocationInternal, error) {
-	if len(name) == 0 || len(mainImageName) == 0 || len(individualImageName) == 0 || len(backgroundImagePath) == 0 || len(color) == 0 || len(address) == 0 || len(startTime) == 0 || len(endTime) == 0 || len(description) == 0 {
+func (a *appLayer) CreateLocation(user *models.UserInternal, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*models.LocationInternal, error) {
+	if len(name) == 0 || len(mainImageName) == 0 || len(individualImageName) == 0 || len(backgroundImagePath) == 0 || len(color) == 0 || len(address) == 0 || len(startTime) == 0 || len(endTime) == 0 || len(description) == 0 || !validCoordinates(latitude, longitude) {
 		return &models.LocationInternal{}, errors.New("bad request")
 	}
 
-	if location, err := a.store.CreateLocation(user.Username, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description); err != nil {
+	if location, err := a.store.CreateLocation(user.Username, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description, latitude, longitude, keywords); err != nil {
 		return &models.LocationInternal{}, err
 	} else {
 		locationInternal := models.LocationInternal{}
@@ -70,12 +70,12 @@ func (a *appLayer) GetAllLocations() (*[]models.LocationInternal, error) {
 	}
 }
 
-func (a *appLayer) UpdateLocation(user *models.UserInternal, id uint, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*models.LocationInternal, error) {
-	if len(name) == 0 || len(mainImageName) == 0 || len(individualImageName) == 0 || len(backgroundImagePath) == 0 || len(color) == 0 || len(address) == 0 || len(startTime) == 0 || len(endTime) == 0 || len(description) == 0 {
+func (a *appLayer) UpdateLocation(user *models.UserInternal, id uint, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*models.LocationInternal, error) {
+	if len(name) == 0 || len(mainImageName) == 0 || len(individualImageName) == 0 || len(backgroundImagePath) == 0 || len(color) == 0 || len(address) == 0 || len(startTime) == 0 || len(endTime) == 0 || len(description) == 0 || !validCoordinates(latitude, longitude) {
 		return &models.LocationInternal{}, errors.New("bad request")
 	}
 
-	if location, err := a.store.UpdateLocation(id, user.Username, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description); err != nil {
+	if location, err := a.store.UpdateLocation(id, user.Username, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description, latitude, longitude, keywords); err != nil {
 		return &models.LocationInternal{}, err
 	} else {
 		locationInternal := models.LocationInternal{}
@@ -84,3 +84,13 @@ func (a *appLayer) UpdateLocation(user *models.UserInternal, id uint, name, main
 		return &locationInternal, nil
 	}
 }
+
+// validCoordinates reports whether a location's coordinates are either both
+// unset or both set and in range.
+func validCoordinates(latitude, longitude *float64) bool {
+	if latitude == nil || longitude == nil {
+		return latitude == nil && longitude == nil
+	}
+
+	return *latitude >= -90 && *latitude <= 90 && *longitude >= -180 && *longitude <= 180
+}
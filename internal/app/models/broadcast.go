@@ -0,0 +1,65 @@
+//
+// Internal Broadcast Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+type BroadcastFilterInternal struct {
+	FieldSlug string `json:"fieldSlug"`
+	Value     string `json:"value"`
+}
+
+type BroadcastInternal struct {
+	ID             uint
+	CreatedAt      time.Time
+	CreatedBy      string
+	EmailSlug      string
+	FormID         uint
+	Filters        []BroadcastFilterInternal
+	RecipientCount uint
+	Pending        int64
+	Sent           int64
+	Failed         int64
+	Suppressed     int64
+}
+
+func (b *BroadcastInternal) Internalize(broadcast *store.Broadcast, statusCounts map[string]int64) {
+	b.ID = broadcast.ID
+	b.CreatedAt = broadcast.CreatedAt
+	b.CreatedBy = broadcast.CreatedBy
+	b.EmailSlug = broadcast.EmailSlug
+	b.FormID = broadcast.FormID
+	b.RecipientCount = broadcast.RecipientCount
+
+	// Messages being handed to the transport right now are still pending from
+	// the point of view of someone watching a broadcast go out.
+	b.Pending = statusCounts[store.OutboundEmailPending] + statusCounts[store.OutboundEmailSending]
+	b.Sent = statusCounts[store.OutboundEmailSent]
+	b.Failed = statusCounts[store.OutboundEmailFailed]
+	b.Suppressed = statusCounts[store.OutboundEmailSuppressed]
+
+	b.Filters = []BroadcastFilterInternal{}
+	if broadcast.Filters != nil {
+		_ = json.Unmarshal([]byte(*broadcast.Filters), &b.Filters)
+	}
+}
@@ -17,22 +17,108 @@
 
 package models
 
-import "github.com/OutClimb/OutClimb/internal/store"
+import (
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+)
 
 type EmailInternal struct {
-	ID       uint
-	Name     string
-	Slug     string
-	Subject  string
-	HtmlBody string
-	TextBody string
+	ID           uint
+	Name         string
+	Slug         string
+	LayoutSlug   *string
+	Subject      string
+	MarkdownBody *string
+	HtmlBody     string
+	TextBody     string
+	Revision     uint
 }
 
 func (e *EmailInternal) Internalize(email *store.Email) {
 	e.ID = email.ID
 	e.Name = email.Name
 	e.Slug = email.Slug
+	e.LayoutSlug = email.LayoutSlug
 	e.Subject = email.Subject
+	e.MarkdownBody = email.MarkdownBody
 	e.HtmlBody = email.HtmlBody
 	e.TextBody = email.TextBody
+	e.Revision = email.Revision
+}
+
+type EmailRevisionInternal struct {
+	EmailID      uint
+	Revision     uint
+	CreatedAt    time.Time
+	CreatedBy    string
+	Name         string
+	Slug         string
+	LayoutSlug   *string
+	Subject      string
+	MarkdownBody *string
+	HtmlBody     string
+	TextBody     string
+}
+
+func (e *EmailRevisionInternal) Internalize(revision *store.EmailRevision) {
+	e.EmailID = revision.EmailID
+	e.Revision = revision.Revision
+	e.CreatedAt = revision.CreatedAt
+	e.CreatedBy = revision.CreatedBy
+	e.Name = revision.Name
+	e.Slug = revision.Slug
+	e.LayoutSlug = revision.LayoutSlug
+	e.Subject = revision.Subject
+	e.MarkdownBody = revision.MarkdownBody
+	e.HtmlBody = revision.HtmlBody
+	e.TextBody = revision.TextBody
+}
+
+type EmailDiffLineInternal struct {
+	Op   string
+	Text string
+}
+
+type EmailFieldDiffInternal struct {
+	Field string
+	Lines []EmailDiffLineInternal
+}
+
+type EmailRevisionDiffInternal struct {
+	From   uint
+	To     uint
+	Fields []EmailFieldDiffInternal
+}
+
+type EmailUsageInternal struct {
+	FormID   uint
+	FormName string
+	FormSlug string
+	Usage    string
+}
+
+func (e *EmailUsageInternal) Internalize(form *store.Form, usage string) {
+	e.FormID = form.ID
+	e.FormName = form.Name
+	e.FormSlug = form.Slug
+	e.Usage = usage
+}
+
+type EmailPartialInternal struct {
+	ID       uint
+	Name     string
+	Slug     string
+	Layout   bool
+	HtmlBody string
+	TextBody string
+}
+
+func (e *EmailPartialInternal) Internalize(partial *store.EmailPartial) {
+	e.ID = partial.ID
+	e.Name = partial.Name
+	e.Slug = partial.Slug
+	e.Layout = partial.Layout
+	e.HtmlBody = partial.HtmlBody
+	e.TextBody = partial.TextBody
 }
@@ -0,0 +1,38 @@
+//
+// Internal Email Suppression Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+import (
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+type EmailSuppressionInternal struct {
+	ID        uint
+	CreatedAt time.Time
+	Address   string
+	Reason    string
+}
+
+func (e *EmailSuppressionInternal) Internalize(suppression *store.EmailSuppression) {
+	e.ID = suppression.ID
+	e.CreatedAt = suppression.CreatedAt
+	e.Address = suppression.Address
+	e.Reason = suppression.Reason
+}
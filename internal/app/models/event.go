@@ -1,6 +1,6 @@
 //
-// Event Model
-// Copyright 2026 OutClimb
+// Internal Event Object
+// Copyright 2025 OutClimb
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -18,50 +18,48 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"github.com/OutClimb/OutClimb/internal/store"
 )
 
 type EventInternal struct {
-	Title          string
-	Link           string
-	Description    string
-	ContentEncoded string
-	MediaURL       string
-	MediaType      string
-	PubDate        string
-	EventDate      time.Time
-	GUID           string
+	ID           uint
+	Title        string
+	StartsAt     time.Time
+	EndsAt       *time.Time
+	LocationID   *uint
+	ImageAssetID *uint
+	Description  string
+	FormID       *uint
+	Link         string
+	Published    bool
+	Categories   []string
 }
 
 func (e *EventInternal) Internalize(event *store.Event) {
+	e.ID = event.ID
 	e.Title = event.Title
-	e.Link = event.Link
+	e.StartsAt = event.StartsAt
+	e.EndsAt = event.EndsAt
+	e.LocationID = event.LocationID
+	e.ImageAssetID = event.ImageAssetID
 	e.Description = event.Description
-	e.ContentEncoded = event.ContentEncoded
-	e.MediaURL = event.MediaURL
-	e.MediaType = event.MediaType
-	e.PubDate = event.PubDate
-	e.EventDate = event.EventDate
-	e.GUID = event.GUID
-}
-
-type EventFeedInternal struct {
-	Title       string
-	Link        string
-	Description string
-	Events      []*EventInternal
+	e.FormID = event.FormID
+	e.Link = event.Link
+	e.Published = event.Published
+	e.Categories = splitCategories(event.Categories)
 }
 
-func (f *EventFeedInternal) Internalize(feed *store.EventFeed) {
-	f.Title = feed.Title
-	f.Link = feed.Link
-	f.Description = feed.Description
-	f.Events = make([]*EventInternal, 0, len(feed.Events))
-	for _, e := range feed.Events {
-		ei := &EventInternal{}
-		ei.Internalize(e)
-		f.Events = append(f.Events, ei)
+// splitCategories reads the newline separated categories stored for an event.
+func splitCategories(categories string) []string {
+	result := []string{}
+	for _, category := range strings.Split(categories, "\n") {
+		if category = strings.TrimSpace(category); len(category) > 0 {
+			result = append(result, category)
+		}
 	}
+
+	return result
 }
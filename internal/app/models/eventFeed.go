@@ -0,0 +1,140 @@
+//
+// Event Feed Model
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+import (
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+type FeedEventInternal struct {
+	Title          string
+	Link           string
+	Description    string
+	ContentEncoded string
+	MediaURL       string
+	MediaType      string
+	PubDate        string
+	EventDate      time.Time
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	DateSource     string
+	GUID           string
+	Categories     []string
+	LocationID     *uint
+
+	// Location is where the event is, if one could be found, and
+	// LocationMatch says how it was found.
+	Location         *LocationInternal
+	LocationMatch    string
+	LocationImageURL string
+
+	// PlainDescription is Description without its markup, only filled in
+	// where it is needed.
+	PlainDescription string
+}
+
+func (e *FeedEventInternal) Internalize(event *store.FeedEvent) {
+	e.Title = event.Title
+	e.Link = event.Link
+	e.Description = event.Description
+	e.ContentEncoded = event.ContentEncoded
+	e.MediaURL = event.MediaURL
+	e.MediaType = event.MediaType
+	e.PubDate = event.PubDate
+	e.EventDate = event.EventDate
+	e.StartsAt = event.StartsAt
+	e.EndsAt = event.EndsAt
+	e.DateSource = event.DateSource
+	e.GUID = event.GUID
+	e.Categories = event.Categories
+}
+
+type EventPageInternal struct {
+	Events []*FeedEventInternal
+	Total  int
+	Limit  int
+	Offset int
+}
+
+type EventFeedInternal struct {
+	Title       string
+	Link        string
+	Description string
+	Events      []*FeedEventInternal
+	FetchedAt   time.Time
+}
+
+func (f *EventFeedInternal) Internalize(feed *store.EventFeed) {
+	f.Title = feed.Title
+	f.Link = feed.Link
+	f.Description = feed.Description
+	f.FetchedAt = feed.FetchedAt
+	f.Events = make([]*FeedEventInternal, 0, len(feed.Events))
+	for _, e := range feed.Events {
+		ei := &FeedEventInternal{}
+		ei.Internalize(e)
+		f.Events = append(f.Events, ei)
+	}
+}
+
+type SkippedEventInternal struct {
+	Title  string
+	Link   string
+	GUID   string
+	Reason string
+}
+
+// EventDiagnosticsInternal describes how the last fetch of the events feed
+// went: how many events were dated by each source and which were skipped.
+type EventDiagnosticsInternal struct {
+	FetchedAt time.Time
+	Events    int
+	Sources   map[string]int
+	Skipped   []SkippedEventInternal
+}
+
+func (d *EventDiagnosticsInternal) Internalize(feed *store.EventFeed) {
+	d.FetchedAt = feed.FetchedAt
+	d.Events = len(feed.Events)
+	d.Sources = make(map[string]int)
+	for _, event := range feed.Events {
+		d.Sources[event.DateSource]++
+	}
+
+	d.Skipped = make([]SkippedEventInternal, len(feed.Skipped))
+	for i, skipped := range feed.Skipped {
+		d.Skipped[i] = SkippedEventInternal{
+			Title:  skipped.Title,
+			Link:   skipped.Link,
+			GUID:   skipped.GUID,
+			Reason: skipped.Reason,
+		}
+	}
+}
+
+// EventProgramInternal is a category of events, which partners can follow as
+// its own feed.
+type EventProgramInternal struct {
+	Name        string
+	Slug        string
+	Events      int
+	FeedURL     string
+	CalendarURL string
+}
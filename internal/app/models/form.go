@@ -38,6 +38,11 @@ type FormInternal struct {
 	ConfirmationEmailSlug      *string
 	NotificationEmailTo        *string
 	NotificationEmailSlug      *string
+	EventStartsAt              *time.Time
+	EventEndsAt                *time.Time
+	EventLocationID            *uint
+	CalendarInvite             bool
+	Reminders                  []FormReminderInternal
 	Status                     string
 	ViewableBy                 []uint
 	Fields                     []FormFieldInternal
@@ -58,6 +63,15 @@ func (f *FormInternal) Internalize(form *store.Form, fields *[]store.FormField)
 	f.ConfirmationEmailSlug = form.ConfirmationEmailSlug
 	f.NotificationEmailTo = form.NotificationEmailTo
 	f.NotificationEmailSlug = form.NotificationEmailSlug
+	f.EventStartsAt = form.EventStartsAt
+	f.EventEndsAt = form.EventEndsAt
+	f.EventLocationID = form.EventLocationID
+	f.CalendarInvite = form.CalendarInvite
+
+	f.Reminders = make([]FormReminderInternal, len(form.Reminders))
+	for i := range form.Reminders {
+		f.Reminders[i].Internalize(&form.Reminders[i])
+	}
 
 	viewableBy := make([]uint, len(form.ViewableBy))
 	for i, u := range form.ViewableBy {
@@ -71,3 +85,15 @@ func (f *FormInternal) Internalize(form *store.Form, fields *[]store.FormField)
 	}
 	f.Fields = fieldList
 }
+
+type FormReminderInternal struct {
+	ID            uint
+	EmailSlug     string
+	OffsetMinutes int
+}
+
+func (r *FormReminderInternal) Internalize(reminder *store.FormReminder) {
+	r.ID = reminder.ID
+	r.EmailSlug = reminder.EmailSlug
+	r.OffsetMinutes = reminder.OffsetMinutes
+}
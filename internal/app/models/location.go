@@ -18,6 +18,8 @@
 package models
 
 import (
+	"strings"
+
 	"github.com/OutClimb/OutClimb/internal/store"
 )
 
@@ -32,6 +34,9 @@ type LocationInternal struct {
 	StartTime           string
 	EndTime             string
 	Description         string
+	Latitude            *float64
+	Longitude           *float64
+	Keywords            []string
 }
 
 func (l *LocationInternal) Internalize(location *store.Location) {
@@ -45,4 +50,20 @@ func (l *LocationInternal) Internalize(location *store.Location) {
 	l.StartTime = location.StartTime
 	l.EndTime = location.EndTime
 	l.Description = location.Description
+	l.Latitude = location.Latitude
+	l.Longitude = location.Longitude
+	l.Keywords = splitKeywords(location.Keywords)
+}
+
+// splitKeywords reads the comma or newline separated keywords a location is
+// matched to events with.
+func splitKeywords(keywords string) []string {
+	result := []string{}
+	for _, keyword := range strings.FieldsFunc(keywords, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if keyword = strings.TrimSpace(keyword); len(keyword) > 0 {
+			result = append(result, keyword)
+		}
+	}
+
+	return result
 }
@@ -0,0 +1,76 @@
+//
+// Internal Outbound Email Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+import (
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+type OutboundEmailInternal struct {
+	ID                uint
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	EmailSlug         string
+	EmailRevision     uint
+	FormID            *uint
+	SubmissionID      *uint
+	BroadcastID       *uint
+	To                []string
+	Subject           string
+	HtmlBody          string
+	TextBody          string
+	Status            string
+	Attempts          uint
+	ProviderMessageID *string
+	NextAttemptAt     time.Time
+	LastAttemptAt     *time.Time
+	LastError         *string
+	SentAt            *time.Time
+	DeliveredAt       *time.Time
+	OpenedAt          *time.Time
+	BouncedAt         *time.Time
+	ComplainedAt      *time.Time
+}
+
+func (o *OutboundEmailInternal) Internalize(outboundEmail *store.OutboundEmail) {
+	o.ID = outboundEmail.ID
+	o.CreatedAt = outboundEmail.CreatedAt
+	o.UpdatedAt = outboundEmail.UpdatedAt
+	o.EmailSlug = outboundEmail.EmailSlug
+	o.EmailRevision = outboundEmail.EmailRevision
+	o.FormID = outboundEmail.FormID
+	o.SubmissionID = outboundEmail.SubmissionID
+	o.BroadcastID = outboundEmail.BroadcastID
+	o.To = outboundEmail.Recipients()
+	o.Subject = outboundEmail.Subject
+	o.HtmlBody = outboundEmail.HtmlBody
+	o.TextBody = outboundEmail.TextBody
+	o.Status = outboundEmail.Status
+	o.Attempts = outboundEmail.Attempts
+	o.ProviderMessageID = outboundEmail.ProviderMessageID
+	o.NextAttemptAt = outboundEmail.NextAttemptAt
+	o.LastAttemptAt = outboundEmail.LastAttemptAt
+	o.LastError = outboundEmail.LastError
+	o.SentAt = outboundEmail.SentAt
+	o.DeliveredAt = outboundEmail.DeliveredAt
+	o.OpenedAt = outboundEmail.OpenedAt
+	o.BouncedAt = outboundEmail.BouncedAt
+	o.ComplainedAt = outboundEmail.ComplainedAt
+}
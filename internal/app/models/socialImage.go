@@ -0,0 +1,32 @@
+//
+// Internal Social Image Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+type SocialImageInternal struct {
+	FileName string
+	Data     []byte
+}
+
+// SocialImagesInternal is a set of rendered images, bundled into a ZIP when
+// there is more than one, and the assets they were saved as when asked to.
+type SocialImagesInternal struct {
+	FileName string
+	Archive  []byte
+	Images   []SocialImageInternal
+	Assets   []AssetInternal
+}
@@ -27,6 +27,7 @@ type SubmissionInternal struct {
 	ID          uint
 	FormID      uint
 	SubmittedOn time.Time
+	Reference   string
 	Values      []SubmissionValueInternal
 }
 
@@ -34,6 +35,7 @@ func (s *SubmissionInternal) Internalize(submission *store.Submission, values *[
 	s.ID = submission.ID
 	s.FormID = submission.FormID
 	s.SubmittedOn = submission.SubmittedOn
+	s.Reference = submission.Reference
 
 	valueList := make([]SubmissionValueInternal, len(*values))
 	for i, v := range *values {
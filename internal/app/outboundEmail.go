@@ -0,0 +1,395 @@
+//
+// Outbound Email Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/mailer"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+var (
+	ErrInvalidOutboundEmailStatus = errors.New("invalid outbound email status")
+	ErrInvalidWebhookPayload      = errors.New("invalid webhook payload")
+	ErrInvalidWebhookSignature    = errors.New("invalid webhook signature")
+	ErrOutboundEmailNotFailed     = errors.New("outbound email has not failed")
+	ErrOutboundEmailNotRendered   = errors.New("outbound email was never rendered")
+)
+
+const (
+	defaultEmailMaxAttempts    = 8
+	defaultEmailRetryDelay     = 30 * time.Second
+	defaultEmailWorkerInterval = 5 * time.Second
+	emailWorkerBatchSize       = 25
+	emailSendLease             = 5 * time.Minute
+	maxEmailRetryDelay         = 6 * time.Hour
+)
+
+func parseDurationOrDefault(value string, fallback time.Duration, name string) time.Duration {
+	if len(value) == 0 {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		slog.Error("Failed to parse "+name+", using default",
+			"layer", "app",
+			"input", value,
+			"default", fallback,
+			"error", err,
+		)
+		return fallback
+	}
+
+	return duration
+}
+
+// emailRetryDelay doubles the base delay for every attempt already made, up to
+// maxEmailRetryDelay.
+func emailRetryDelay(base time.Duration, attempts uint) time.Duration {
+	delay := base
+	for i := uint(1); i < attempts && delay < maxEmailRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxEmailRetryDelay {
+		return maxEmailRetryDelay
+	}
+
+	return delay
+}
+
+func (a *appLayer) emailMaxAttempts() uint {
+	if a.config.EmailMaxAttempts <= 0 {
+		return defaultEmailMaxAttempts
+	}
+
+	return uint(a.config.EmailMaxAttempts)
+}
+
+// RunEmailWorker sends queued email until ctx is cancelled.
+func (a *appLayer) RunEmailWorker(ctx context.Context) {
+	interval := parseDurationOrDefault(a.config.EmailWorkerInterval, defaultEmailWorkerInterval, "email worker interval")
+	retryDelay := parseDurationOrDefault(a.config.EmailRetryDelay, defaultEmailRetryDelay, "email retry delay")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		a.processOutboundEmails(retryDelay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *appLayer) processOutboundEmails(retryDelay time.Duration) {
+	claimed, err := a.store.ClaimOutboundEmails(emailWorkerBatchSize, emailSendLease)
+	if err != nil {
+		slog.Error("Unable to claim outbound emails",
+			"layer", "app",
+			"entity", "outboundEmail",
+			"error", err,
+		)
+		return
+	}
+
+	for i := range *claimed {
+		a.sendOutboundEmail(&(*claimed)[i], retryDelay)
+	}
+}
+
+func (a *appLayer) sendOutboundEmail(outboundEmail *store.OutboundEmail, retryDelay time.Duration) {
+	// Transactional email such as confirmations is always sent. Bulk email
+	// is checked again at send time since the recipient may have
+	// unsubscribed after it was queued.
+	if outboundEmail.Bulk {
+		suppressed, err := suppressedAddresses(a.store, outboundEmail.Recipients())
+		if err != nil {
+			a.failOutboundEmail(outboundEmail, fmt.Errorf("unable to check email suppressions: %w", err), retryDelay)
+			return
+		}
+
+		if len(suppressed) > 0 {
+			if err := a.store.MarkOutboundEmailSuppressed(outboundEmail.ID); err != nil {
+				slog.Error("Unable to mark outbound email as suppressed",
+					"layer", "app",
+					"entity", "outboundEmail",
+					"id", outboundEmail.ID,
+					"error", err,
+				)
+			}
+			return
+		}
+	}
+
+	providerMessageId, sendErr := a.deliverEmail(outboundEmail)
+	if sendErr == nil {
+		if err := a.store.MarkOutboundEmailSent(outboundEmail.ID, providerMessageId); err != nil {
+			slog.Error("Unable to mark outbound email as sent",
+				"layer", "app",
+				"entity", "outboundEmail",
+				"id", outboundEmail.ID,
+				"error", err,
+			)
+		}
+		return
+	}
+
+	a.failOutboundEmail(outboundEmail, sendErr, retryDelay)
+}
+
+// failOutboundEmail records why a claimed message could not be sent and
+// either schedules its next attempt or, once attempts run out, leaves it
+// dead-lettered.
+func (a *appLayer) failOutboundEmail(outboundEmail *store.OutboundEmail, sendErr error, retryDelay time.Duration) {
+	var nextAttemptAt *time.Time
+	if outboundEmail.Attempts < a.emailMaxAttempts() {
+		next := time.Now().Add(emailRetryDelay(retryDelay, outboundEmail.Attempts))
+		nextAttemptAt = &next
+	}
+
+	slog.Error("Unable to send outbound email",
+		"layer", "app",
+		"entity", "outboundEmail",
+		"id", outboundEmail.ID,
+		"slug", outboundEmail.EmailSlug,
+		"attempts", outboundEmail.Attempts,
+		"willRetry", nextAttemptAt != nil,
+		"error", sendErr,
+	)
+
+	if err := a.store.MarkOutboundEmailFailed(outboundEmail.ID, sendErr.Error(), nextAttemptAt); err != nil {
+		slog.Error("Unable to record outbound email failure",
+			"layer", "app",
+			"entity", "outboundEmail",
+			"id", outboundEmail.ID,
+			"error", err,
+		)
+	}
+}
+
+// canViewOutboundEmail applies the submission rules to the outbox, since a
+// rendered message carries the registrant's answers. Messages that belong to
+// no form, or to one since deleted, are left to owners and form editors.
+func (a *appLayer) canViewOutboundEmail(user *models.UserInternal, formId *uint, forms map[uint]*models.FormInternal) (bool, error) {
+	var form *models.FormInternal
+	if formId != nil {
+		var ok bool
+		if form, ok = forms[*formId]; !ok {
+			var err error
+			if form, err = a.loadFormInternal(*formId); err != nil && !store.IsNotFound(err) {
+				return false, err
+			}
+			forms[*formId] = form
+		}
+	}
+
+	if form == nil {
+		return user.Role == "Owner" || user.Permissions["form"] >= uint(store.LevelWrite), nil
+	}
+
+	return canViewSubmissions(user, form), nil
+}
+
+func (a *appLayer) GetOutboundEmail(user *models.UserInternal, id uint) (*models.OutboundEmailInternal, error) {
+	outboundEmail, err := a.store.GetOutboundEmail(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok, err := a.canViewOutboundEmail(user, outboundEmail.FormID, map[uint]*models.FormInternal{}); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrForbidden
+	}
+
+	internal := models.OutboundEmailInternal{}
+	internal.Internalize(outboundEmail)
+	return &internal, nil
+}
+
+// GetOutboundEmails lists the outbox, leaving out messages for forms whose
+// submissions the user cannot see.
+func (a *appLayer) GetOutboundEmails(user *models.UserInternal, status string, formId, submissionId *uint, recipient string) (*[]models.OutboundEmailInternal, error) {
+	outboundEmails, err := a.getOutboundEmails(status, formId, submissionId, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	forms := map[uint]*models.FormInternal{}
+	result := make([]models.OutboundEmailInternal, 0, len(*outboundEmails))
+	for _, outboundEmail := range *outboundEmails {
+		if ok, err := a.canViewOutboundEmail(user, outboundEmail.FormID, forms); err != nil {
+			return nil, err
+		} else if ok {
+			result = append(result, outboundEmail)
+		}
+	}
+	return &result, nil
+}
+
+func (a *appLayer) getOutboundEmails(status string, formId, submissionId *uint, recipient string) (*[]models.OutboundEmailInternal, error) {
+	switch status {
+	case "", store.OutboundEmailPending, store.OutboundEmailSending, store.OutboundEmailSent, store.OutboundEmailFailed, store.OutboundEmailSuppressed:
+	default:
+		return nil, ErrInvalidOutboundEmailStatus
+	}
+
+	outboundEmails, err := a.store.GetOutboundEmails(status, formId, submissionId, strings.TrimSpace(recipient))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.OutboundEmailInternal, len(*outboundEmails))
+	for i := range *outboundEmails {
+		result[i].Internalize(&(*outboundEmails)[i])
+	}
+	return &result, nil
+}
+
+// RetryOutboundEmail puts a dead-lettered message back in the queue with a
+// fresh set of attempts.
+func (a *appLayer) RetryOutboundEmail(user *models.UserInternal, id uint) (*models.OutboundEmailInternal, error) {
+	existing, err := a.store.GetOutboundEmail(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok, err := a.canViewOutboundEmail(user, existing.FormID, map[uint]*models.FormInternal{}); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrForbidden
+	}
+
+	if existing.Status != store.OutboundEmailFailed {
+		return nil, ErrOutboundEmailNotFailed
+	}
+
+	// A message whose template failed to render has nothing to send; it has
+	// to be queued again once the template is fixed.
+	if existing.HtmlBody == "" && existing.TextBody == "" {
+		return nil, ErrOutboundEmailNotRendered
+	}
+
+	outboundEmail, err := a.store.RetryOutboundEmail(id)
+	if err != nil {
+		slog.Error("Unable to retry outbound email",
+			"layer", "app",
+			"entity", "outboundEmail",
+			"id", id,
+			"error", err,
+		)
+		return nil, err
+	}
+
+	internal := models.OutboundEmailInternal{}
+	internal.Internalize(outboundEmail)
+	return &internal, nil
+}
+
+// ReceiveEmailWebhook records the delivery events the provider reports in the
+// delivery log. Hard bounces and spam complaints also suppress the address so
+// no more bulk email is sent to it. Events for messages we do not know are
+// skipped rather than failed, since the provider would only retry them.
+func (a *appLayer) ReceiveEmailWebhook(header http.Header, body []byte) error {
+	events, err := a.webhooks.Parse(header, body)
+	if errors.Is(err, mailer.ErrInvalidWebhookSignature) {
+		return ErrInvalidWebhookSignature
+	} else if err != nil {
+		return ErrInvalidWebhookPayload
+	}
+
+	for _, event := range events {
+		outboundEmail, err := a.store.GetOutboundEmailWithProviderMessageID(event.ProviderMessageID)
+		if err != nil {
+			slog.Warn("Received delivery event for unknown email",
+				"layer", "app",
+				"entity", "outboundEmail",
+				"providerMessageId", event.ProviderMessageID,
+				"type", event.Type,
+			)
+			continue
+		}
+
+		occurredAt := event.OccurredAt
+		if occurredAt.IsZero() {
+			occurredAt = time.Now()
+		}
+
+		recipients := event.Recipients
+		if len(recipients) == 0 {
+			recipients = outboundEmail.Recipients()
+		}
+
+		var suppressReason string
+		switch event.Type {
+		case mailer.DeliveryEventDelivered:
+			err = a.store.MarkOutboundEmailDelivered(outboundEmail.ID, occurredAt)
+		case mailer.DeliveryEventOpened:
+			err = a.store.MarkOutboundEmailOpened(outboundEmail.ID, occurredAt)
+		case mailer.DeliveryEventBounced:
+			err = a.store.MarkOutboundEmailBounced(outboundEmail.ID, occurredAt, event.Detail)
+			if event.HardBounce {
+				suppressReason = store.SuppressionReasonBounce
+			}
+		case mailer.DeliveryEventComplained:
+			err = a.store.MarkOutboundEmailComplained(outboundEmail.ID, occurredAt)
+			suppressReason = store.SuppressionReasonComplaint
+		}
+		if err != nil {
+			slog.Error("Unable to record delivery event",
+				"layer", "app",
+				"entity", "outboundEmail",
+				"id", outboundEmail.ID,
+				"type", event.Type,
+				"error", err,
+			)
+			return err
+		}
+
+		if len(suppressReason) == 0 {
+			continue
+		}
+
+		for _, recipient := range recipients {
+			if _, err := a.store.CreateEmailSuppression(normalizeEmailAddress(recipient), suppressReason); err != nil {
+				slog.Error("Unable to suppress address",
+					"layer", "app",
+					"entity", "emailSuppression",
+					"reason", suppressReason,
+					"error", err,
+				)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
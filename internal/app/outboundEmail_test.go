@@ -0,0 +1,470 @@
+//
+// Outbound Email Tests
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/mailer"
+	"github.com/OutClimb/OutClimb/internal/store"
+	"github.com/OutClimb/OutClimb/internal/utils"
+	"gorm.io/gorm"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+// outboxStore keeps the outbox and suppression list in memory. It embeds
+// StoreLayer so only the calls the worker and webhooks make need an
+// implementation; anything else panics.
+type outboxStore struct {
+	store.StoreLayer
+
+	mu             sync.Mutex
+	emails         map[uint]*store.OutboundEmail
+	forms          map[uint]*store.Form
+	suppressions   map[string]string
+	suppressionErr error
+}
+
+func newOutboxStore(emails ...store.OutboundEmail) *outboxStore {
+	s := &outboxStore{
+		emails:       map[uint]*store.OutboundEmail{},
+		forms:        map[uint]*store.Form{},
+		suppressions: map[string]string{},
+	}
+	for i := range emails {
+		email := emails[i]
+		s.emails[email.ID] = &email
+	}
+	return s
+}
+
+func (s *outboxStore) email(id uint) store.OutboundEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.emails[id]
+}
+
+func (s *outboxStore) ClaimOutboundEmails(limit int, lease time.Duration) (*[]store.OutboundEmail, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	claimed := []store.OutboundEmail{}
+	for _, email := range s.emails {
+		if len(claimed) == limit {
+			break
+		}
+		if (email.Status != store.OutboundEmailPending && email.Status != store.OutboundEmailSending) || email.NextAttemptAt.After(now) {
+			continue
+		}
+
+		email.Status = store.OutboundEmailSending
+		email.Attempts++
+		email.LastAttemptAt = &now
+		email.NextAttemptAt = now.Add(lease)
+		claimed = append(claimed, *email)
+	}
+	return &claimed, nil
+}
+
+func (s *outboxStore) CreateEmailSuppression(address, reason string) (*store.EmailSuppression, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.suppressions[address]; !ok {
+		s.suppressions[address] = reason
+	}
+	return &store.EmailSuppression{Address: address, Reason: s.suppressions[address]}, nil
+}
+
+func (s *outboxStore) GetEmailSuppressionsForAddresses(addresses []string) (*[]store.EmailSuppression, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.suppressionErr != nil {
+		return nil, s.suppressionErr
+	}
+
+	result := []store.EmailSuppression{}
+	for _, address := range addresses {
+		if reason, ok := s.suppressions[address]; ok {
+			result = append(result, store.EmailSuppression{Address: address, Reason: reason})
+		}
+	}
+	return &result, nil
+}
+
+func (s *outboxStore) GetAllFormFieldsForForm(formId uint) (*[]store.FormField, error) {
+	return &[]store.FormField{}, nil
+}
+
+func (s *outboxStore) GetForm(id uint) (*store.Form, error) {
+	form, ok := s.forms[id]
+	if !ok {
+		return &store.Form{}, gorm.ErrRecordNotFound
+	}
+	return form, nil
+}
+
+func (s *outboxStore) GetOutboundEmail(id uint) (*store.OutboundEmail, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	email, ok := s.emails[id]
+	if !ok {
+		return &store.OutboundEmail{}, gorm.ErrRecordNotFound
+	}
+	return email, nil
+}
+
+func (s *outboxStore) GetOutboundEmails(status string, formId, submissionId *uint, recipient string) (*[]store.OutboundEmail, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	emails := []store.OutboundEmail{}
+	for id := uint(1); id <= uint(len(s.emails)); id++ {
+		emails = append(emails, *s.emails[id])
+	}
+	return &emails, nil
+}
+
+func (s *outboxStore) GetOutboundEmailAttachments(outboundEmailId uint) (*[]store.OutboundEmailAttachment, error) {
+	return &[]store.OutboundEmailAttachment{}, nil
+}
+
+func (s *outboxStore) GetOutboundEmailWithProviderMessageID(providerMessageId string) (*store.OutboundEmail, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, email := range s.emails {
+		if email.ProviderMessageID != nil && *email.ProviderMessageID == providerMessageId {
+			copied := *email
+			return &copied, nil
+		}
+	}
+	return &store.OutboundEmail{}, errors.New("record not found")
+}
+
+func (s *outboxStore) MarkOutboundEmailBounced(id uint, bouncedAt time.Time, detail string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emails[id].BouncedAt = &bouncedAt
+	s.emails[id].LastError = &detail
+	return nil
+}
+
+func (s *outboxStore) MarkOutboundEmailDelivered(id uint, deliveredAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emails[id].DeliveredAt = &deliveredAt
+	return nil
+}
+
+func (s *outboxStore) MarkOutboundEmailFailed(id uint, lastError string, nextAttemptAt *time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	email := s.emails[id]
+	email.LastError = &lastError
+	if nextAttemptAt == nil {
+		email.Status = store.OutboundEmailFailed
+	} else {
+		email.Status = store.OutboundEmailPending
+		email.NextAttemptAt = *nextAttemptAt
+	}
+	return nil
+}
+
+func (s *outboxStore) MarkOutboundEmailSent(id uint, providerMessageId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.emails[id].Status = store.OutboundEmailSent
+	s.emails[id].ProviderMessageID = &providerMessageId
+	s.emails[id].SentAt = &now
+	return nil
+}
+
+func (s *outboxStore) MarkOutboundEmailSuppressed(id uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emails[id].Status = store.OutboundEmailSuppressed
+	return nil
+}
+
+func newEmailTestApp(s *outboxStore, m *mailer.MemoryMailer) *appLayer {
+	return &appLayer{
+		config: &utils.AppConfig{
+			EmailFromAddress:  "OutClimb <hello@example.com>",
+			EmailMaxAttempts:  3,
+			PublicURL:         "https://example.com",
+			UnsubscribeSecret: "unsubscribe-secret",
+		},
+		store:    s,
+		mailer:   m,
+		webhooks: mailer.NewFixtureWebhookReceiver(testWebhookSecret),
+		location: time.UTC,
+	}
+}
+
+func pendingEmail(id uint, to string, bulk bool) store.OutboundEmail {
+	return store.OutboundEmail{
+		ID:            id,
+		EmailSlug:     "confirmation",
+		To:            to,
+		Subject:       "You're registered",
+		HtmlBody:      "<p>See you there</p>",
+		TextBody:      "See you there",
+		Bulk:          bulk,
+		Status:        store.OutboundEmailPending,
+		NextAttemptAt: time.Now().Add(-time.Minute),
+	}
+}
+
+func TestEmailWorkerSendsDueEmail(t *testing.T) {
+	outbox := newOutboxStore(pendingEmail(1, "alex@example.com", false))
+	memory := mailer.NewMemoryMailer()
+
+	newEmailTestApp(outbox, memory).processOutboundEmails(time.Minute)
+
+	messages := memory.Messages()
+	if len(messages) != 1 || messages[0].To[0] != "alex@example.com" {
+		t.Fatalf("unexpected messages: %+v", messages)
+	}
+	if messages[0].Headers != nil {
+		t.Fatal("transactional email should not carry unsubscribe headers")
+	}
+
+	email := outbox.email(1)
+	if email.Status != store.OutboundEmailSent || email.ProviderMessageID == nil || *email.ProviderMessageID != "memory-1" {
+		t.Fatalf("email not marked sent: %+v", email)
+	}
+}
+
+func TestEmailWorkerSkipsEmailNotYetDue(t *testing.T) {
+	email := pendingEmail(1, "alex@example.com", false)
+	email.NextAttemptAt = time.Now().Add(time.Hour)
+	outbox := newOutboxStore(email)
+	memory := mailer.NewMemoryMailer()
+
+	newEmailTestApp(outbox, memory).processOutboundEmails(time.Minute)
+
+	if len(memory.Messages()) != 0 {
+		t.Fatal("email sent before it was due")
+	}
+	if outbox.email(1).Attempts != 0 {
+		t.Fatal("email claimed before it was due")
+	}
+}
+
+func TestEmailWorkerBacksOffThenDeadLetters(t *testing.T) {
+	outbox := newOutboxStore(pendingEmail(1, "alex@example.com", false))
+	memory := mailer.NewMemoryMailer()
+	memory.Err = errors.New("connection refused")
+	app := newEmailTestApp(outbox, memory)
+
+	for attempt := uint(1); attempt <= 3; attempt++ {
+		before := time.Now()
+		app.processOutboundEmails(time.Minute)
+
+		email := outbox.email(1)
+		if email.Attempts != attempt {
+			t.Fatalf("attempt %d: attempts recorded as %d", attempt, email.Attempts)
+		}
+		if email.LastError == nil || *email.LastError != "connection refused" {
+			t.Fatalf("attempt %d: last error not recorded: %v", attempt, email.LastError)
+		}
+
+		if attempt < 3 {
+			if email.Status != store.OutboundEmailPending {
+				t.Fatalf("attempt %d: status %q, want pending", attempt, email.Status)
+			}
+			if wait := email.NextAttemptAt.Sub(before); wait < emailRetryDelay(time.Minute, attempt) {
+				t.Fatalf("attempt %d: retried after %v, want at least %v", attempt, wait, emailRetryDelay(time.Minute, attempt))
+			}
+
+			// Make the retry due without waiting out the backoff.
+			outbox.mu.Lock()
+			outbox.emails[1].NextAttemptAt = time.Now().Add(-time.Second)
+			outbox.mu.Unlock()
+		} else if email.Status != store.OutboundEmailFailed {
+			t.Fatalf("attempt %d: status %q, want failed", attempt, email.Status)
+		}
+	}
+
+	// A dead-lettered email is left alone by later runs.
+	app.processOutboundEmails(time.Minute)
+	if attempts := outbox.email(1).Attempts; attempts != 3 {
+		t.Fatalf("dead-lettered email claimed again, attempts %d", attempts)
+	}
+}
+
+func TestEmailRetryDelayDoublesUpToCap(t *testing.T) {
+	tests := []struct {
+		attempts uint
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{30, maxEmailRetryDelay},
+	}
+
+	for _, test := range tests {
+		if got := emailRetryDelay(30*time.Second, test.attempts); got != test.want {
+			t.Errorf("emailRetryDelay(30s, %d) = %v, want %v", test.attempts, got, test.want)
+		}
+	}
+}
+
+func TestEmailWorkerSuppressesBulkEmail(t *testing.T) {
+	outbox := newOutboxStore(pendingEmail(1, "alex@example.com", true), pendingEmail(2, "sam@example.com", true))
+	outbox.suppressions["alex@example.com"] = store.SuppressionReasonUnsubscribe
+	memory := mailer.NewMemoryMailer()
+
+	newEmailTestApp(outbox, memory).processOutboundEmails(time.Minute)
+
+	if status := outbox.email(1).Status; status != store.OutboundEmailSuppressed {
+		t.Fatalf("suppressed recipient: status %q, want suppressed", status)
+	}
+
+	messages := memory.Messages()
+	if len(messages) != 1 || messages[0].To[0] != "sam@example.com" {
+		t.Fatalf("unexpected messages: %+v", messages)
+	}
+	if _, ok := messages[0].Headers["List-Unsubscribe"]; !ok {
+		t.Fatal("bulk email should carry a List-Unsubscribe header")
+	}
+}
+
+func TestEmailWorkerRetriesWhenSuppressionCheckFails(t *testing.T) {
+	outbox := newOutboxStore(pendingEmail(1, "alex@example.com", true))
+	outbox.suppressionErr = errors.New("database unavailable")
+	memory := mailer.NewMemoryMailer()
+
+	newEmailTestApp(outbox, memory).processOutboundEmails(time.Minute)
+
+	email := outbox.email(1)
+	if len(memory.Messages()) != 0 {
+		t.Fatal("email sent without a suppression check")
+	}
+	if email.Status != store.OutboundEmailPending || email.LastError == nil || email.Attempts != 1 {
+		t.Fatalf("lookup failure not recorded for retry: %+v", email)
+	}
+}
+
+func signedWebhook(t *testing.T, events []mailer.DeliveryEvent) (http.Header, []byte) {
+	t.Helper()
+
+	body, err := json.Marshal(events)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	header := http.Header{}
+	header.Set(mailer.FixtureSignatureHeader, mailer.SignFixture(testWebhookSecret, body))
+	return header, body
+}
+
+func TestReceiveEmailWebhookRecordsEventsForSentEmail(t *testing.T) {
+	outbox := newOutboxStore(pendingEmail(1, "alex@example.com", false))
+	memory := mailer.NewMemoryMailer()
+	app := newEmailTestApp(outbox, memory)
+	app.processOutboundEmails(time.Minute)
+
+	header, body := signedWebhook(t, []mailer.DeliveryEvent{
+		{Type: mailer.DeliveryEventDelivered, ProviderMessageID: "memory-1"},
+		{Type: mailer.DeliveryEventBounced, ProviderMessageID: "memory-1", HardBounce: true, Detail: "mailbox full"},
+		{Type: mailer.DeliveryEventDelivered, ProviderMessageID: "unknown"},
+	})
+	if err := app.ReceiveEmailWebhook(header, body); err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+
+	email := outbox.email(1)
+	if email.DeliveredAt == nil || email.BouncedAt == nil {
+		t.Fatalf("delivery events not recorded: %+v", email)
+	}
+	if reason := outbox.suppressions["alex@example.com"]; reason != store.SuppressionReasonBounce {
+		t.Fatalf("hard bounce should suppress the address, got reason %q", reason)
+	}
+}
+
+func TestReceiveEmailWebhookRejectsBadSignature(t *testing.T) {
+	outbox := newOutboxStore()
+	app := newEmailTestApp(outbox, mailer.NewMemoryMailer())
+
+	header, body := signedWebhook(t, []mailer.DeliveryEvent{{Type: mailer.DeliveryEventComplained, ProviderMessageID: "memory-1"}})
+	header.Set(mailer.FixtureSignatureHeader, mailer.SignFixture("wrong-secret", body))
+
+	if err := app.ReceiveEmailWebhook(header, body); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("got %v, want ErrInvalidWebhookSignature", err)
+	}
+	if len(outbox.suppressions) != 0 {
+		t.Fatal("unsigned complaint should not suppress anyone")
+	}
+}
+
+func TestOutboxOnlyShowsEmailsForViewableForms(t *testing.T) {
+	viewable := pendingEmail(1, "alex@example.com", false)
+	viewableFormId, hiddenFormId, deletedFormId := uint(10), uint(20), uint(30)
+	viewable.FormID = &viewableFormId
+	hidden := pendingEmail(2, "sam@example.com", false)
+	hidden.FormID = &hiddenFormId
+	deleted := pendingEmail(3, "jo@example.com", false)
+	deleted.FormID = &deletedFormId
+	unlinked := pendingEmail(4, "kit@example.com", false)
+
+	outbox := newOutboxStore(viewable, hidden, deleted, unlinked)
+	viewer := store.User{}
+	viewer.ID = 7
+	outbox.forms[viewableFormId] = &store.Form{ViewableBy: []store.User{viewer}}
+	outbox.forms[hiddenFormId] = &store.Form{}
+	a := newEmailTestApp(outbox, mailer.NewMemoryMailer())
+
+	reader := &models.UserInternal{ID: 7, Role: "Organizer", Permissions: map[string]uint{"email": uint(store.LevelRead), "form": uint(store.LevelRead)}}
+	emails, err := a.GetOutboundEmails(reader, "", nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*emails) != 1 || (*emails)[0].ID != 1 {
+		t.Fatalf("reader should only see the email for the form they can view: %+v", *emails)
+	}
+
+	if _, err := a.GetOutboundEmail(reader, 2); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+	if _, err := a.GetOutboundEmail(reader, 4); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for an email with no form, got %v", err)
+	}
+
+	editor := &models.UserInternal{ID: 8, Role: "Organizer", Permissions: map[string]uint{"email": uint(store.LevelRead), "form": uint(store.LevelWrite)}}
+	emails, err = a.GetOutboundEmails(editor, "", nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*emails) != 4 {
+		t.Fatalf("form editor should see every email: %+v", *emails)
+	}
+}
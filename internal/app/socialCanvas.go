@@ -0,0 +1,232 @@
+//
+// Social Image Canvas
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-fonts/liberation/liberationsansbold"
+	"github.com/go-fonts/liberation/liberationsansregular"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+const (
+	socialImageWidth  = 4500
+	socialImageHeight = 5625
+
+	// socialCurveKappa places the control points of a cubic Bézier so it
+	// follows a quarter circle.
+	socialCurveKappa = 0.5522847498
+)
+
+// socialFonts holds the typefaces the social images are set in. The designs
+// use Poppins, which is not bundled and is only read from the font directory,
+// and Arial. Both fall back to the embedded Liberation Sans, which has the
+// same metrics as Arial.
+type socialFonts struct {
+	poppins map[int]*opentype.Font
+	arial   map[int]*opentype.Font
+}
+
+var socialPoppinsFiles = map[int]string{
+	400: "Poppins-Regular.ttf",
+	500: "Poppins-Medium.ttf",
+	700: "Poppins-Bold.ttf",
+}
+
+func loadSocialFonts(directory string) *socialFonts {
+	regular, err := opentype.Parse(liberationsansregular.TTF)
+	if err != nil {
+		panic(err)
+	}
+	bold, err := opentype.Parse(liberationsansbold.TTF)
+	if err != nil {
+		panic(err)
+	}
+
+	fonts := &socialFonts{
+		poppins: map[int]*opentype.Font{400: regular, 500: regular, 700: bold},
+		arial:   map[int]*opentype.Font{400: regular, 500: regular, 700: bold},
+	}
+
+	if len(directory) == 0 {
+		slog.Warn("No social image font directory configured, using Liberation Sans for Poppins",
+			"layer", "app",
+			"entity", "social",
+		)
+		return fonts
+	}
+
+	for weight, name := range socialPoppinsFiles {
+		data, err := os.ReadFile(filepath.Join(directory, name))
+		if err == nil {
+			var parsed *opentype.Font
+			if parsed, err = opentype.Parse(data); err == nil {
+				fonts.poppins[weight] = parsed
+				continue
+			}
+		}
+
+		slog.Warn("Unable to load social image font, using Liberation Sans",
+			"layer", "app",
+			"entity", "social",
+			"font", name,
+			"error", err,
+		)
+	}
+
+	return fonts
+}
+
+// face sizes a typeface in pixels, the unit the canvas designs use.
+func (f *socialFonts) face(family map[int]*opentype.Font, weight int, size float64) font.Face {
+	face, err := opentype.NewFace(family[weight], &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingNone,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return face
+}
+
+// socialCanvas draws in the same coordinates, and with the same text
+// baseline, as the browser canvas the designs were first made with.
+type socialCanvas struct {
+	img *image.RGBA
+}
+
+func newSocialCanvas(background image.Image) *socialCanvas {
+	c := &socialCanvas{img: image.NewRGBA(image.Rect(0, 0, socialImageWidth, socialImageHeight))}
+	if background != nil {
+		draw.Draw(c.img, c.img.Bounds(), background, background.Bounds().Min, draw.Over)
+	}
+
+	return c
+}
+
+func socialMeasureText(face font.Face, text string) float64 {
+	return float64(font.MeasureString(face, text)) / 64
+}
+
+func (c *socialCanvas) fillText(face font.Face, fill color.Color, text string, x, y float64) {
+	drawer := font.Drawer{
+		Dst:  c.img,
+		Src:  image.NewUniform(fill),
+		Face: face,
+		Dot:  fixed.Point26_6{X: fixed.Int26_6(math.Round(x * 64)), Y: fixed.Int26_6(math.Round(y * 64))},
+	}
+	drawer.DrawString(text)
+}
+
+func (c *socialCanvas) fillMultiLineText(face font.Face, fill color.Color, text string, lineHeight, x, y float64) {
+	for i, line := range strings.Split(text, "\n") {
+		c.fillText(face, fill, line, x, y+lineHeight*float64(i))
+	}
+}
+
+// fillCenterAlignMultiLineText centers each line within the width w.
+func (c *socialCanvas) fillCenterAlignMultiLineText(face font.Face, fill color.Color, text string, lineHeight, x, y, w float64) {
+	for i, line := range strings.Split(text, "\n") {
+		c.fillText(face, fill, line, x+(w/2-socialMeasureText(face, line)/2), y+lineHeight*float64(i))
+	}
+}
+
+// fillRoundRect fills a rectangle whose corners are rounded to radius r,
+// which is clamped so a radius of half the height gives a pill.
+func (c *socialCanvas) fillRoundRect(fill color.Color, x, y, w, h, r float64) {
+	if w <= 0 || h <= 0 {
+		return
+	}
+	r = math.Max(0, math.Min(r, math.Min(w, h)/2))
+
+	// The rasterizer only covers the rectangle's pixels, as one the size of
+	// the whole image would need hundreds of megabytes.
+	left, top := math.Floor(x), math.Floor(y)
+	right, bottom := math.Ceil(x+w), math.Ceil(y+h)
+	z := vector.NewRasterizer(int(right-left), int(bottom-top))
+
+	ox, oy := float32(x-left), float32(y-top)
+	fw, fh, fr := float32(w), float32(h), float32(r)
+	k := fr * socialCurveKappa
+
+	z.MoveTo(ox+fr, oy)
+	z.LineTo(ox+fw-fr, oy)
+	z.CubeTo(ox+fw-fr+k, oy, ox+fw, oy+fr-k, ox+fw, oy+fr)
+	z.LineTo(ox+fw, oy+fh-fr)
+	z.CubeTo(ox+fw, oy+fh-fr+k, ox+fw-fr+k, oy+fh, ox+fw-fr, oy+fh)
+	z.LineTo(ox+fr, oy+fh)
+	z.CubeTo(ox+fr-k, oy+fh, ox, oy+fh-fr+k, ox, oy+fh-fr)
+	z.LineTo(ox, oy+fr)
+	z.CubeTo(ox, oy+fr-k, ox+fr-k, oy, ox+fr, oy)
+	z.ClosePath()
+
+	z.Draw(c.img, image.Rect(int(left), int(top), int(right), int(bottom)), image.NewUniform(fill), image.Point{})
+}
+
+// strokeRoundRect fills a rounded rectangle with an outline of the given width
+// centered on its edge, as a canvas fill followed by a stroke does.
+func (c *socialCanvas) strokeRoundRect(fill, stroke color.Color, lineWidth, x, y, w, h, r float64) {
+	half := lineWidth / 2
+	c.fillRoundRect(stroke, x-half, y-half, w+lineWidth, h+lineWidth, r+half)
+	c.fillRoundRect(fill, x+half, y+half, w-lineWidth, h-lineWidth, r-half)
+}
+
+func (c *socialCanvas) png() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, c.img); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// parseSocialColor reads CSS hex colors such as "#7374B7" or "#fff", falling
+// back to black for anything else.
+func parseSocialColor(value string) color.Color {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(value) == 3 {
+		value = string([]byte{value[0], value[0], value[1], value[1], value[2], value[2]})
+	}
+
+	if len(value) != 6 {
+		return color.Black
+	}
+
+	rgb, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return color.Black
+	}
+
+	return color.RGBA{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 0xff}
+}
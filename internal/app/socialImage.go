@@ -0,0 +1,477 @@
+//
+// Social Image Logic
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/store"
+)
+
+const (
+	defaultSocialImageDirectory = "./web/manager"
+
+	socialMainBackground    = "/manage/images/main-image-bg.png"
+	socialQtbipocBackground = "/manage/images/qtbipoc-bg.png"
+
+	socialBackgroundTimeout = 30 * time.Second
+)
+
+var (
+	ErrSocialBackgroundNotFound = errors.New("social image background not found")
+
+	socialAccent      = color.RGBA{R: 0x73, G: 0x74, B: 0xb7, A: 0xff}
+	socialQtbipocText = color.RGBA{R: 0x59, G: 0x59, B: 0x59, A: 0xff}
+)
+
+// SocialImageEventInput is one event on the monthly images. Day is a date in
+// the configured timezone, and the times are shown as written.
+type SocialImageEventInput struct {
+	Day         time.Time
+	StartTime   string
+	EndTime     string
+	LocationID  uint
+	Address     string
+	Description string
+}
+
+// MonthlySocialImageInput asks for the monthly images. When Events is empty
+// the published events in the month are used.
+type MonthlySocialImageInput struct {
+	Year   int
+	Month  time.Month
+	Events []SocialImageEventInput
+	Save   bool
+}
+
+type QtbipocSocialImageInput struct {
+	Day             time.Time
+	StartTime       string
+	EndTime         string
+	WhenDescription string
+	LocationID      uint
+	Cost            string
+	Save            bool
+}
+
+// RenderMonthlySocialImages draws the month's overview image and one image per
+// event, in date order.
+func (a *appLayer) RenderMonthlySocialImages(user *models.UserInternal, input MonthlySocialImageInput) (*models.SocialImagesInternal, error) {
+	if input.Save && !canSaveSocialImages(user) {
+		return nil, ErrForbidden
+	}
+
+	problems := &ValidationError{}
+	if input.Month < time.January || input.Month > time.December {
+		problems.add("month", "month must be between 1 and 12")
+	}
+	if input.Year < 2000 || input.Year > 9999 {
+		problems.add("year", "year is not valid")
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	locations, err := a.socialLocations()
+	if err != nil {
+		return nil, err
+	}
+
+	events := input.Events
+	if len(events) == 0 {
+		if events, err = a.monthlySocialEvents(input.Year, input.Month, locations); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(events) == 0 {
+		problems.add("events", "there are no published events with a location in this month")
+	}
+	for i, event := range events {
+		if event.Day.IsZero() {
+			problems.add("events["+strconv.Itoa(i)+"].day", "day is required")
+		}
+		if _, ok := locations[event.LocationID]; !ok {
+			problems.add("events["+strconv.Itoa(i)+"].locationId", "location does not exist")
+		}
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	events = append([]SocialImageEventInput{}, events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Day.Before(events[j].Day)
+	})
+
+	main, err := a.renderSocialMainImage(input.Year, input.Month, events, locations)
+	if err != nil {
+		return nil, err
+	}
+
+	images := []models.SocialImageInternal{main}
+	for i, event := range events {
+		rendered, err := a.renderSocialEventImage(i+1, event, locations[event.LocationID])
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, rendered)
+	}
+
+	return a.socialImageResult(user, "SocialImages-"+strconv.FormatInt(time.Now().UnixMilli(), 10)+".zip", images, input.Save)
+}
+
+// RenderQtbipocSocialImage draws the image announcing a QTBIPOC night.
+func (a *appLayer) RenderQtbipocSocialImage(user *models.UserInternal, input QtbipocSocialImageInput) (*models.SocialImagesInternal, error) {
+	if input.Save && !canSaveSocialImages(user) {
+		return nil, ErrForbidden
+	}
+
+	problems := &ValidationError{}
+	if input.Day.IsZero() {
+		problems.add("day", "day is required")
+	}
+
+	location, err := a.store.GetLocation(input.LocationID)
+	if err != nil {
+		problems.add("locationId", "location does not exist")
+	}
+	if err := problems.errOrNil(); err != nil {
+		return nil, err
+	}
+
+	background, err := a.loadSocialBackground(socialQtbipocBackground)
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := newSocialCanvas(background)
+	face := a.socialFonts.face(a.socialFonts.arial, 700, 195)
+
+	when := formatSocialDay(input.Day) + "\n" + input.StartTime + " - " + input.EndTime + "\n" + input.WhenDescription
+	canvas.fillMultiLineText(face, socialQtbipocText, when, 235, 1207, 1769)
+	canvas.fillMultiLineText(face, socialQtbipocText, location.Name+"\n"+location.Address, 235, 1207, 2955)
+	canvas.fillMultiLineText(face, socialQtbipocText, input.Cost, 235, 1207, 4142)
+
+	data, err := canvas.png()
+	if err != nil {
+		return nil, err
+	}
+
+	name := "QTBIPOC - " + input.Day.Format("2006-01-02") + ".png"
+	return a.socialImageResult(user, name, []models.SocialImageInternal{{FileName: name, Data: data}}, input.Save)
+}
+
+func (a *appLayer) renderSocialMainImage(year int, month time.Month, events []SocialImageEventInput, locations map[uint]*store.Location) (models.SocialImageInternal, error) {
+	background, err := a.loadSocialBackground(socialMainBackground)
+	if err != nil {
+		return models.SocialImageInternal{}, err
+	}
+
+	canvas := newSocialCanvas(background)
+	poppins := a.socialFonts.poppins
+
+	// The month and year, on a pill
+	face := a.socialFonts.face(poppins, 700, 147)
+	date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Format("January 2006")
+	dateWidth := socialMeasureText(face, date)
+	pillWidth := dateWidth + 172*2
+	canvas.fillRoundRect(socialAccent, socialImageWidth/2-pillWidth/2, 331, pillWidth, 318, 159)
+	canvas.fillText(face, color.White, date, socialImageWidth/2-dateWidth/2, 547)
+
+	// The header
+	face = a.socialFonts.face(poppins, 700, 333)
+	header := "CLIMBING EVENTS"
+	canvas.fillText(face, socialAccent, header, socialImageWidth/2-socialMeasureText(face, header)/2, 1068)
+
+	// One row per event
+	dayFace := a.socialFonts.face(poppins, 700, 150)
+	nameFace := a.socialFonts.face(poppins, 700, 133)
+	timeFace := a.socialFonts.face(poppins, 500, 99)
+	rowHeight := float64(socialImageHeight-2469) / float64(len(events))
+	for i, event := range events {
+		location := locations[event.LocationID]
+		top := 1399 + rowHeight*float64(i) + rowHeight/2 - 366/2
+
+		canvas.strokeRoundRect(color.White, socialAccent, 8, 1346, top, 2266, 366, 183)
+
+		day := strings.ToUpper(event.Day.Format("Mon")) + "\n" + event.Day.Format("1/2")
+		canvas.fillCenterAlignMultiLineText(dayFace, socialAccent, day, 179, 743, top+143, 603)
+		canvas.fillText(nameFace, socialAccent, location.MainImageName, 1460, top+170)
+		canvas.fillText(timeFace, socialAccent, event.StartTime+" - "+event.EndTime, 1460, top+303)
+	}
+
+	data, err := canvas.png()
+	if err != nil {
+		return models.SocialImageInternal{}, err
+	}
+
+	return models.SocialImageInternal{FileName: "0 - Main Image.png", Data: data}, nil
+}
+
+func (a *appLayer) renderSocialEventImage(number int, event SocialImageEventInput, location *store.Location) (models.SocialImageInternal, error) {
+	background, err := a.loadSocialBackground(location.BackgroundImagePath)
+	if err != nil {
+		return models.SocialImageInternal{}, err
+	}
+
+	canvas := newSocialCanvas(background)
+	poppins := a.socialFonts.poppins
+	accent := parseSocialColor(location.Color)
+
+	face := a.socialFonts.face(poppins, 700, 327)
+	canvas.fillMultiLineText(face, accent, strings.ToUpper(location.IndividualImageName), 395, 269, 547)
+
+	face = a.socialFonts.face(poppins, 700, 146)
+	canvas.fillText(face, accent, formatSocialDay(event.Day), 269, 1704)
+	canvas.fillText(face, accent, event.StartTime+" - "+event.EndTime, 269, 1879)
+
+	face = a.socialFonts.face(poppins, 500, 106)
+	canvas.fillMultiLineText(face, color.Black, event.Address, 120, 269, 2223)
+
+	face = a.socialFonts.face(poppins, 400, 106)
+	canvas.fillMultiLineText(face, color.Black, event.Description, 117, 269, 2710)
+
+	data, err := canvas.png()
+	if err != nil {
+		return models.SocialImageInternal{}, err
+	}
+
+	return models.SocialImageInternal{FileName: strconv.Itoa(number) + " - " + location.Name + ".png", Data: data}, nil
+}
+
+// monthlySocialEvents turns the month's published events into image input,
+// taking the address, and the times and description when the event has none,
+// from its location. Events without a location are left out.
+func (a *appLayer) monthlySocialEvents(year int, month time.Month, locations map[uint]*store.Location) ([]SocialImageEventInput, error) {
+	events, err := a.store.GetPublishedEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := []SocialImageEventInput{}
+	for _, event := range *events {
+		startsAt := event.StartsAt.In(a.location)
+		if startsAt.Year() != year || startsAt.Month() != month || event.LocationID == nil {
+			continue
+		}
+
+		location, ok := locations[*event.LocationID]
+		if !ok {
+			continue
+		}
+
+		input := SocialImageEventInput{
+			Day:         startsAt,
+			StartTime:   location.StartTime,
+			EndTime:     location.EndTime,
+			LocationID:  location.ID,
+			Address:     location.Address,
+			Description: strings.TrimSpace(event.Description),
+		}
+		if startsAt.Hour() != 0 || startsAt.Minute() != 0 {
+			input.StartTime = startsAt.Format("3:04 PM")
+			if event.EndsAt != nil {
+				input.EndTime = event.EndsAt.In(a.location).Format("3:04 PM")
+			}
+		}
+		if len(input.Description) == 0 {
+			input.Description = location.Description
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
+func (a *appLayer) socialLocations() (map[uint]*store.Location, error) {
+	locations, err := a.store.GetAllLocations()
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uint]*store.Location, len(*locations))
+	for i := range *locations {
+		byId[(*locations)[i].ID] = &(*locations)[i]
+	}
+
+	return byId, nil
+}
+
+// loadSocialBackground reads a background image. Paths under /manage/ are
+// the manager's own images on disk, paths under /q/ are assets, and anything
+// else must be a URL.
+func (a *appLayer) loadSocialBackground(source string) (image.Image, error) {
+	var reader io.ReadCloser
+	switch {
+	case strings.HasPrefix(source, "/manage/"):
+		directory := a.config.SocialImageDirectory
+		if len(directory) == 0 {
+			directory = defaultSocialImageDirectory
+		}
+
+		file, err := os.Open(filepath.Join(directory, filepath.FromSlash(path.Clean("/"+strings.TrimPrefix(source, "/manage/")))))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrSocialBackgroundNotFound, source)
+		}
+		reader = file
+	case len(a.socialBackgroundAsset(source)) > 0:
+		// Only our own assets are fetched, never whatever URL is given.
+		assetURL, err := a.store.FindAsset(a.socialBackgroundAsset(source))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrSocialBackgroundNotFound, source)
+		}
+
+		client := http.Client{Timeout: socialBackgroundTimeout}
+		resp, err := client.Get(assetURL)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("%w: %s returned %s", ErrSocialBackgroundNotFound, source, resp.Status)
+		}
+		reader = resp.Body
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrSocialBackgroundNotFound, source)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	background, _, err := image.Decode(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return background, nil
+}
+
+// socialBackgroundAsset returns the asset a background refers to, given as a
+// /q/ path or as a full URL on the assets domain, or "" for anything else.
+func (a *appLayer) socialBackgroundAsset(source string) string {
+	if name, ok := strings.CutPrefix(source, "/q/"); ok {
+		return name
+	}
+
+	if len(a.config.AssetsURL) == 0 {
+		return ""
+	}
+	assets, err := url.Parse(a.config.AssetsURL)
+	if err != nil {
+		return ""
+	}
+	parsed, err := url.Parse(source)
+	if err != nil || parsed.Scheme != assets.Scheme || parsed.Host != assets.Host {
+		return ""
+	}
+
+	name, _ := strings.CutPrefix(parsed.Path, "/q/")
+	if name == parsed.Path {
+		return ""
+	}
+	return name
+}
+
+// socialImageResult bundles the images into a ZIP when there are several, and
+// saves each as an asset when asked to.
+func (a *appLayer) socialImageResult(user *models.UserInternal, fileName string, images []models.SocialImageInternal, save bool) (*models.SocialImagesInternal, error) {
+	result := &models.SocialImagesInternal{
+		FileName: fileName,
+		Images:   images,
+		Assets:   []models.AssetInternal{},
+	}
+
+	if len(images) > 1 {
+		var buffer bytes.Buffer
+		archive := zip.NewWriter(&buffer)
+		for _, rendered := range images {
+			writer, err := archive.Create(rendered.FileName)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := writer.Write(rendered.Data); err != nil {
+				return nil, err
+			}
+		}
+		if err := archive.Close(); err != nil {
+			return nil, err
+		}
+		result.Archive = buffer.Bytes()
+	}
+
+	if !save {
+		return result, nil
+	}
+
+	// Asset names are unique, so each run gets its own prefix.
+	prefix := "social-" + time.Now().In(a.location).Format("20060102-150405") + "-"
+	for _, rendered := range images {
+		name := slugify(strings.TrimSuffix(rendered.FileName, ".png"))
+		asset, err := a.CreateAsset(user, prefix+name+".png", "image/png", base64.StdEncoding.EncodeToString(rendered.Data))
+		if err != nil {
+			return nil, err
+		}
+		result.Assets = append(result.Assets, *asset)
+	}
+
+	return result, nil
+}
+
+// canSaveSocialImages reports whether the user may create assets, as saving
+// the images does.
+func canSaveSocialImages(user *models.UserInternal) bool {
+	return user.Role == "Owner" || user.Permissions["asset"] >= uint(store.LevelWrite)
+}
+
+// formatSocialDay writes a day as "Saturday, March 1st".
+func formatSocialDay(day time.Time) string {
+	suffix := "th"
+	if day.Day() < 11 || day.Day() > 13 {
+		switch day.Day() % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+
+	return day.Format("Monday, January 2") + suffix
+}
@@ -0,0 +1,72 @@
+//
+// Validation Errors
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package app
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrValidation = errors.New("validation failed")
+
+type ValidationProblem struct {
+	Field   string
+	Line    int
+	Message string
+}
+
+// ValidationError collects every problem found while validating an entity so
+// they can be reported back together instead of one at a time.
+type ValidationError struct {
+	Problems []ValidationProblem
+}
+
+func (v *ValidationError) add(field, message string) {
+	v.Problems = append(v.Problems, ValidationProblem{Field: field, Message: message})
+}
+
+// addAt records a problem at a line within the field, for fields that hold
+// multi-line text such as templates.
+func (v *ValidationError) addAt(field string, line int, message string) {
+	v.Problems = append(v.Problems, ValidationProblem{Field: field, Line: line, Message: message})
+}
+
+func (v *ValidationError) Error() string {
+	messages := make([]string, len(v.Problems))
+	for i, p := range v.Problems {
+		if p.Line > 0 {
+			messages[i] = p.Field + ":" + strconv.Itoa(p.Line) + ": " + p.Message
+		} else {
+			messages[i] = p.Field + ": " + p.Message
+		}
+	}
+	return ErrValidation.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (v *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// errOrNil returns the validation error only when problems were found.
+func (v *ValidationError) errOrNil() error {
+	if len(v.Problems) == 0 {
+		return nil
+	}
+	return v
+}
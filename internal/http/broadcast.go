@@ -0,0 +1,147 @@
+//
+// Broadcast Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func parseBroadcastRequest(c *gin.Context) (*responses.BroadcastRequestPublic, []app.BroadcastFilterInput, bool) {
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return nil, nil, false
+	}
+
+	body := responses.BroadcastRequestPublic{}
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return nil, nil, false
+	}
+
+	filters := make([]app.BroadcastFilterInput, len(body.Filters))
+	for i, f := range body.Filters {
+		filters[i] = app.BroadcastFilterInput{
+			FieldSlug: f.FieldSlug,
+			Value:     f.Value,
+		}
+	}
+
+	return &body, filters, true
+}
+
+func respondWithBroadcastError(c *gin.Context, message string, err error) {
+	if errors.Is(err, app.ErrEmailNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Email not found"})
+	} else if errors.Is(err, app.ErrFormNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Form not found"})
+	} else if errors.Is(err, app.ErrForbidden) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, app.ErrBulkEmailNotConfigured) {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Bulk email is not configured"})
+	} else if !respondWithValidationError(c, "Invalid broadcast", err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func (h *httpLayer) createBroadcast(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	body, filters, ok := parseBroadcastRequest(c)
+	if !ok {
+		return
+	}
+
+	broadcast, err := h.app.CreateBroadcast(user, body.EmailId, body.FormId, filters)
+	if err != nil {
+		respondWithBroadcastError(c, "Unable to create broadcast", err)
+		return
+	}
+
+	resp := responses.BroadcastPublic{}
+	resp.Publicize(broadcast)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getBroadcast(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	broadcast, err := h.app.GetBroadcast(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Broadcast not found"})
+		return
+	}
+
+	resp := responses.BroadcastPublic{}
+	resp.Publicize(broadcast)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getBroadcasts(c *gin.Context) {
+	broadcasts, err := h.app.GetAllBroadcasts()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve broadcasts"})
+		return
+	}
+
+	result := make([]responses.BroadcastPublic, len(*broadcasts))
+	for i := range *broadcasts {
+		result[i].Publicize(&(*broadcasts)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *httpLayer) previewBroadcast(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	body, filters, ok := parseBroadcastRequest(c)
+	if !ok {
+		return
+	}
+
+	count, err := h.app.PreviewBroadcast(user, body.FormId, filters)
+	if err != nil {
+		respondWithBroadcastError(c, "Unable to preview broadcast", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.BroadcastPreviewPublic{RecipientCount: count})
+}
@@ -0,0 +1,79 @@
+//
+// Digest Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *httpLayer) getDigestPreference(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	preference, err := h.app.GetDigestPreference(user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve digest preference"})
+		return
+	}
+
+	resp := responses.DigestPreferencePublic{}
+	resp.Publicize(preference)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) updateDigestPreference(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	body := responses.DigestPreferencePublic{}
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	preference, err := h.app.UpdateDigestPreference(user, body.Enabled, body.Weekday, body.Hour)
+	if err != nil {
+		if !respondWithValidationError(c, "Invalid digest preference", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to update digest preference"})
+		}
+		return
+	}
+
+	resp := responses.DigestPreferencePublic{}
+	resp.Publicize(preference)
+	c.JSON(http.StatusOK, resp)
+}
@@ -19,9 +19,11 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/OutClimb/OutClimb/internal/app"
 	"github.com/OutClimb/OutClimb/internal/http/middleware"
 	"github.com/OutClimb/OutClimb/internal/http/responses"
 	"github.com/gin-gonic/gin"
@@ -47,9 +49,11 @@ func (h *httpLayer) createEmail(c *gin.Context) {
 		return
 	}
 
-	email, err := h.app.CreateEmail(user, body.Name, body.Slug, body.Subject, body.HtmlBody, body.TextBody)
+	email, err := h.app.CreateEmail(user, body.Name, body.Slug, body.LayoutSlug, body.Subject, body.MarkdownBody, body.HtmlBody, body.TextBody)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create email"})
+		if !respondWithValidationError(c, "Invalid email", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create email"})
+		}
 		return
 	}
 
@@ -66,7 +70,13 @@ func (h *httpLayer) deleteEmail(c *gin.Context) {
 	}
 
 	if err := h.app.DeleteEmail(uint(id)); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to delete email"})
+		if errors.Is(err, app.ErrEmailNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+		} else if errors.Is(err, app.ErrEmailInUse) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Email is still used by a form"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to delete email"})
+		}
 		return
 	}
 
@@ -91,6 +101,30 @@ func (h *httpLayer) getEmail(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+func (h *httpLayer) getEmailUsage(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	usage, err := h.app.GetEmailUsage(uint(id))
+	if errors.Is(err, app.ErrEmailNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve email usage"})
+		return
+	}
+
+	result := make([]responses.EmailUsagePublic, len(*usage))
+	for i := range *usage {
+		result[i].Publicize(&(*usage)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 func (h *httpLayer) getEmails(c *gin.Context) {
 	emails, err := h.app.GetAllEmails()
 	if err != nil {
@@ -106,6 +140,59 @@ func (h *httpLayer) getEmails(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+func (h *httpLayer) previewEmail(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	body := responses.EmailPreviewRequestPublic{}
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	preview, err := h.app.PreviewEmail(user, uint(id), body.FormId, body.SubmissionId, body.Values, body.SendTest)
+	if errors.Is(err, app.ErrEmailNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+		return
+	} else if errors.Is(err, app.ErrFormNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Form not found"})
+		return
+	} else if errors.Is(err, app.ErrSubmissionNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Submission not found"})
+		return
+	} else if errors.Is(err, app.ErrForbidden) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to preview email"})
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.EmailPreviewPublic{
+		Subject:    preview.Subject,
+		HtmlBody:   preview.HtmlBody,
+		TextBody:   preview.TextBody,
+		Errors:     publicizeValidationProblems(preview.Problems),
+		TestQueued: preview.TestQueued,
+	})
+}
+
 func (h *httpLayer) updateEmail(c *gin.Context) {
 	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
 	user, err := h.app.GetUser(userClaim.ID)
@@ -132,9 +219,13 @@ func (h *httpLayer) updateEmail(c *gin.Context) {
 		return
 	}
 
-	email, err := h.app.UpdateEmail(user, uint(id), body.Name, body.Slug, body.Subject, body.HtmlBody, body.TextBody)
+	email, err := h.app.UpdateEmail(user, uint(id), body.Name, body.Slug, body.LayoutSlug, body.Subject, body.MarkdownBody, body.HtmlBody, body.TextBody)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to update email"})
+		if errors.Is(err, app.ErrEmailNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+		} else if !respondWithValidationError(c, "Invalid email", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to update email"})
+		}
 		return
 	}
 
@@ -0,0 +1,158 @@
+//
+// Email Partial Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *httpLayer) createEmailPartial(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	body := responses.EmailPartialPublic{}
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	partial, err := h.app.CreateEmailPartial(user, body.Name, body.Slug, body.Layout, body.HtmlBody, body.TextBody)
+	if err != nil {
+		if !respondWithValidationError(c, "Invalid partial", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create partial"})
+		}
+		return
+	}
+
+	resp := responses.EmailPartialPublic{}
+	resp.Publicize(partial)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) deleteEmailPartial(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	if err := h.app.DeleteEmailPartial(uint(id)); err != nil {
+		if errors.Is(err, app.ErrEmailPartialNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Partial not found"})
+		} else if errors.Is(err, app.ErrEmailPartialInUse) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Partial is still used by an email or another partial"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to delete partial"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *httpLayer) getEmailPartial(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	partial, err := h.app.GetEmailPartial(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Partial not found"})
+		return
+	}
+
+	resp := responses.EmailPartialPublic{}
+	resp.Publicize(partial)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getEmailPartials(c *gin.Context) {
+	partials, err := h.app.GetAllEmailPartials()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve partials"})
+		return
+	}
+
+	result := make([]responses.EmailPartialPublic, len(*partials))
+	for i := range *partials {
+		result[i].Publicize(&(*partials)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *httpLayer) updateEmailPartial(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	body := responses.EmailPartialPublic{}
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	partial, err := h.app.UpdateEmailPartial(user, uint(id), body.Name, body.Slug, body.Layout, body.HtmlBody, body.TextBody)
+	if err != nil {
+		if errors.Is(err, app.ErrEmailPartialNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Partial not found"})
+		} else if !respondWithValidationError(c, "Invalid partial", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to update partial"})
+		}
+		return
+	}
+
+	resp := responses.EmailPartialPublic{}
+	resp.Publicize(partial)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,157 @@
+//
+// Email Revision Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+// respondWithEmailRevisionError writes the response for errors shared by the
+// revision routes and reports whether it did.
+func respondWithEmailRevisionError(c *gin.Context, err error) bool {
+	if errors.Is(err, app.ErrEmailNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+		return true
+	} else if errors.Is(err, app.ErrEmailRevisionNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+		return true
+	}
+
+	return false
+}
+
+func (h *httpLayer) diffEmailRevisions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	from, err := strconv.ParseUint(c.Query("from"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from revision"})
+		return
+	}
+
+	to, err := strconv.ParseUint(c.Query("to"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to revision"})
+		return
+	}
+
+	diff, err := h.app.DiffEmailRevisions(uint(id), uint(from), uint(to))
+	if err != nil {
+		if !respondWithEmailRevisionError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to compare revisions"})
+		}
+		return
+	}
+
+	resp := responses.EmailRevisionDiffPublic{}
+	resp.Publicize(diff)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getEmailRevision(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	revision, err := strconv.ParseUint(c.Param("revision"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision"})
+		return
+	}
+
+	emailRevision, err := h.app.GetEmailRevision(uint(id), uint(revision))
+	if err != nil {
+		if !respondWithEmailRevisionError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve revision"})
+		}
+		return
+	}
+
+	resp := responses.EmailRevisionPublic{}
+	resp.Publicize(emailRevision)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getEmailRevisions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	revisions, err := h.app.GetEmailRevisions(uint(id))
+	if err != nil {
+		if !respondWithEmailRevisionError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve revisions"})
+		}
+		return
+	}
+
+	result := make([]responses.EmailRevisionPublic, len(*revisions))
+	for i := range *revisions {
+		result[i].Publicize(&(*revisions)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *httpLayer) restoreEmailRevision(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	revision, err := strconv.ParseUint(c.Param("revision"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision"})
+		return
+	}
+
+	email, err := h.app.RestoreEmailRevision(user, uint(id), uint(revision))
+	if err != nil {
+		if !respondWithEmailRevisionError(c, err) && !respondWithValidationError(c, "Revision can no longer be used", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to restore revision"})
+		}
+		return
+	}
+
+	resp := responses.EmailPublic{}
+	resp.Publicize(email)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,149 @@
+//
+// Email Suppression Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"net/http"
+	"strconv"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+// unsubscribePage is shown to people following an unsubscribe link. Opening
+// the link only asks for confirmation so that link scanners cannot
+// unsubscribe anyone; mail clients use the one-click POST directly.
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Unsubscribe</title>
+</head>
+<body style="font-family:sans-serif;max-width:32rem;margin:4rem auto;padding:0 1rem">
+{{if .Done}}
+<p>{{.Address}} will no longer receive these emails.</p>
+{{else if .Invalid}}
+<p>This unsubscribe link is not valid.</p>
+{{else}}
+<p>Stop sending these emails to {{.Address}}?</p>
+<form method="post">
+<button type="submit">Unsubscribe</button>
+</form>
+{{end}}
+</body>
+</html>
+`))
+
+type unsubscribePageData struct {
+	Address string
+	Done    bool
+	Invalid bool
+}
+
+func renderUnsubscribePage(c *gin.Context, status int, data unsubscribePageData) {
+	c.Status(status)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	_ = unsubscribePage.Execute(c.Writer, data)
+}
+
+func (h *httpLayer) createEmailSuppression(c *gin.Context) {
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	body := responses.EmailSuppressionPublic{}
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	suppression, err := h.app.CreateEmailSuppression(body.Address)
+	if err != nil {
+		if !respondWithValidationError(c, "Invalid suppression", err) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create suppression"})
+		}
+		return
+	}
+
+	resp := responses.EmailSuppressionPublic{}
+	resp.Publicize(suppression)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) deleteEmailSuppression(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	if err := h.app.DeleteEmailSuppression(uint(id)); err != nil {
+		if errors.Is(err, app.ErrEmailSuppressionNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Suppression not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to delete suppression"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *httpLayer) getEmailSuppressions(c *gin.Context) {
+	suppressions, err := h.app.GetAllEmailSuppressions()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve suppressions"})
+		return
+	}
+
+	result := make([]responses.EmailSuppressionPublic, len(*suppressions))
+	for i := range *suppressions {
+		result[i].Publicize(&(*suppressions)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *httpLayer) getUnsubscribe(c *gin.Context) {
+	renderUnsubscribePage(c, http.StatusOK, unsubscribePageData{Address: c.Query("address")})
+}
+
+// unsubscribe handles both the confirmation form and RFC 8058 one-click
+// requests from mail clients, which post to the URL from the
+// List-Unsubscribe header.
+func (h *httpLayer) unsubscribe(c *gin.Context) {
+	address := c.Query("address")
+
+	if err := h.app.Unsubscribe(address, c.Query("token")); err != nil {
+		if errors.Is(err, app.ErrInvalidUnsubscribeToken) {
+			renderUnsubscribePage(c, http.StatusBadRequest, unsubscribePageData{Address: address, Invalid: true})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to unsubscribe"})
+		}
+		return
+	}
+
+	renderUnsubscribePage(c, http.StatusOK, unsubscribePageData{Address: address, Done: true})
+}
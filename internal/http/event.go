@@ -0,0 +1,167 @@
+//
+// Event Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *httpLayer) createEvent(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Get the body data
+	bodyAsByteArray, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	// Parse the body data
+	body := responses.EventPublic{}
+	err = json.Unmarshal(bodyAsByteArray, &body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	event, err := h.app.CreateEvent(user, body.Title, body.StartsOn, body.EndsOn, body.LocationId, body.ImageAssetId, body.Description, body.FormId, body.Link, body.Published, body.Categories)
+	if respondWithValidationError(c, "Invalid event", err) {
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create event"})
+		return
+	}
+
+	eventPublic := responses.EventPublic{}
+	eventPublic.Publicize(event)
+
+	c.JSON(http.StatusOK, eventPublic)
+}
+
+func (h *httpLayer) deleteEvent(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	err = h.app.DeleteEvent(uint(id))
+	if errors.Is(err, app.ErrEventNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Event not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to delete event"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *httpLayer) getEvent(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	internalEvent, err := h.app.GetEvent(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Event not found"})
+		return
+	}
+
+	event := responses.EventPublic{}
+	event.Publicize(internalEvent)
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (h *httpLayer) getManagedEvents(c *gin.Context) {
+	internalEvents, err := h.app.GetAllEvents()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve events"})
+		return
+	}
+
+	events := make([]responses.EventPublic, len(*internalEvents))
+	for i := range *internalEvents {
+		events[i].Publicize(&(*internalEvents)[i])
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+func (h *httpLayer) updateEvent(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Get the id from the URL
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	// Get the body data
+	bodyAsByteArray, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	// Parse the body data
+	body := responses.EventPublic{}
+	err = json.Unmarshal(bodyAsByteArray, &body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	event, err := h.app.UpdateEvent(user, uint(id), body.Title, body.StartsOn, body.EndsOn, body.LocationId, body.ImageAssetId, body.Description, body.FormId, body.Link, body.Published, body.Categories)
+	if errors.Is(err, app.ErrEventNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Event not found"})
+		return
+	} else if respondWithValidationError(c, "Invalid event", err) {
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to update event"})
+		return
+	}
+
+	eventPublic := responses.EventPublic{}
+	eventPublic.Publicize(event)
+
+	c.JSON(http.StatusOK, eventPublic)
+}
@@ -0,0 +1,100 @@
+//
+// Event Location Override Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *httpLayer) deleteEventLocationOverride(c *gin.Context) {
+	eventKey := c.Query("eventKey")
+	if len(eventKey) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Event key is required"})
+		return
+	}
+
+	err := h.app.DeleteEventLocationOverride(eventKey)
+	if errors.Is(err, app.ErrEventLocationOverrideNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Event location override not found"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to delete event location override"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *httpLayer) getEventLocationOverrides(c *gin.Context) {
+	internalOverrides, err := h.app.GetEventLocationOverrides()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve event location overrides"})
+		return
+	}
+
+	overrides := make([]responses.EventLocationOverridePublic, len(*internalOverrides))
+	for i := range *internalOverrides {
+		overrides[i].Publicize(&(*internalOverrides)[i])
+	}
+
+	c.JSON(http.StatusOK, overrides)
+}
+
+func (h *httpLayer) setEventLocationOverride(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Get the body data
+	bodyAsByteArray, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	// Parse the body data
+	body := responses.EventLocationOverridePublic{}
+	err = json.Unmarshal(bodyAsByteArray, &body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	override, err := h.app.SetEventLocationOverride(user, body.EventKey, body.LocationId)
+	if respondWithValidationError(c, "Invalid event location override", err) {
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to save event location override"})
+		return
+	}
+
+	overridePublic := responses.EventLocationOverridePublic{}
+	overridePublic.Publicize(override)
+
+	c.JSON(http.StatusOK, overridePublic)
+}
@@ -18,16 +18,80 @@
 package http
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/xml"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/app/models"
 	"github.com/OutClimb/OutClimb/internal/http/responses"
 	"github.com/gin-gonic/gin"
 )
 
+func (h *httpLayer) getEvents(c *gin.Context) {
+	query := app.EventQueryInput{Filter: eventFilterQuery(c), Sort: c.Query("sort")}
+
+	var err error
+	if query.From, err = optionalDateQuery(c, "from"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date, expected YYYY-MM-DD"})
+		return
+	}
+
+	if query.To, err = optionalDateQuery(c, "to"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date, expected YYYY-MM-DD"})
+		return
+	}
+
+	if query.Limit, query.Offset, err = pageQuery(c); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit or offset"})
+		return
+	}
+
+	page, err := h.app.GetEvents(query)
+	if respondWithValidationError(c, "Invalid event query", err) {
+		return
+	} else if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	resp := responses.EventPageDisplay{}
+	resp.Publicize(page)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getUpcomingEvents(c *gin.Context) {
+	limit, offset, err := pageQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit or offset"})
+		return
+	}
+
+	page, err := h.app.GetUpcomingEvents(limit, offset, eventFilterQuery(c))
+	if respondWithValidationError(c, "Invalid event query", err) {
+		return
+	} else if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	resp := responses.EventPageDisplay{}
+	resp.Publicize(page)
+	c.JSON(http.StatusOK, resp)
+}
+
 func (h *httpLayer) getEventsForMonth(c *gin.Context) {
+	if param, ok := strings.CutSuffix(c.Param("month"), ".ics"); ok {
+		h.getEventsCalendarForMonth(c, param)
+		return
+	}
+
 	month, err := time.Parse("2006-01", c.Param("month"))
 	if err != nil {
 		slog.Error("invalid month format", "param", c.Param("month"), "err", err)
@@ -35,13 +99,62 @@ func (h *httpLayer) getEventsForMonth(c *gin.Context) {
 		return
 	}
 
-	feed, err := h.app.GetEventsForMonth(month.Year(), month.Month())
+	feed, err := h.app.GetEventsForMonth(month.Year(), month.Month(), eventFilterQuery(c))
+	if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	respondWithEventFeed(c, feed)
+}
+
+// getProgramEvents serves the upcoming events in one category, as RSS or, with
+// a .ics suffix, as a calendar, for partners to embed.
+func (h *httpLayer) getProgramEvents(c *gin.Context) {
+	program, calendar := strings.CutSuffix(c.Param("program"), ".ics")
+	filter := app.EventFilter{Category: program, Location: c.Query("location")}
+
+	if calendar {
+		body, err := h.app.GetUpcomingEventsCalendar(filter)
+		if err != nil {
+			slog.Error("Unable to fetch events", "err", err)
+			c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+			return
+		}
+
+		respondWithETag(c, "text/calendar; charset=utf-8", body)
+		return
+	}
+
+	feed, err := h.app.GetUpcomingEventsFeed(filter)
+	if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	respondWithEventFeed(c, feed)
+}
+
+func (h *httpLayer) getEventPrograms(c *gin.Context) {
+	internalPrograms, err := h.app.GetEventPrograms()
 	if err != nil {
 		slog.Error("Unable to fetch events", "err", err)
 		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
 		return
 	}
 
+	programs := make([]responses.EventProgramPublic, len(*internalPrograms))
+	for i := range *internalPrograms {
+		programs[i].Publicize(&(*internalPrograms)[i])
+	}
+
+	c.JSON(http.StatusOK, programs)
+}
+
+// respondWithEventFeed sends events as an RSS feed.
+func respondWithEventFeed(c *gin.Context, feed *models.EventFeedInternal) {
 	var resp responses.EventFeedPublic
 	resp.Publicize(feed)
 
@@ -52,5 +165,87 @@ func (h *httpLayer) getEventsForMonth(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/rss+xml; charset=utf-8", append([]byte(xml.Header), output...))
+	respondWithETag(c, "application/rss+xml; charset=utf-8", append([]byte(xml.Header), output...))
+}
+
+func (h *httpLayer) getEventsCalendarForMonth(c *gin.Context, param string) {
+	month, err := time.Parse("2006-01", param)
+	if err != nil {
+		slog.Error("invalid month format", "param", param, "err", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid month format, expected YYYY-MM"})
+		return
+	}
+
+	body, err := h.app.GetEventsCalendarForMonth(month.Year(), month.Month(), eventFilterQuery(c))
+	if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	respondWithETag(c, "text/calendar; charset=utf-8", body)
+}
+
+func (h *httpLayer) getUpcomingEventsCalendar(c *gin.Context) {
+	body, err := h.app.GetUpcomingEventsCalendar(eventFilterQuery(c))
+	if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	respondWithETag(c, "text/calendar; charset=utf-8", body)
+}
+
+// respondWithETag sends body with an ETag over its content, or a 304 when the
+// client already has it.
+func respondWithETag(c *gin.Context, contentType string, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	c.Header("ETag", etag)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// etagMatches reports whether an If-None-Match header lists etag. Weak
+// validators match too, as the comparison for If-None-Match is weak.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *httpLayer) refreshEvents(c *gin.Context) {
+	feed, err := h.app.RefreshEvents()
+	if err != nil {
+		slog.Error("Unable to refresh events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	var resp responses.EventRefreshPublic
+	resp.Publicize(feed)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *httpLayer) getEventDiagnostics(c *gin.Context) {
+	diagnostics, err := h.app.GetEventDiagnostics()
+	if err != nil {
+		slog.Error("Unable to fetch events", "err", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to fetch events"})
+		return
+	}
+
+	var resp responses.EventDiagnosticsPublic
+	resp.Publicize(diagnostics)
+	c.JSON(http.StatusOK, resp)
 }
@@ -49,6 +49,20 @@ func (h *httpLayer) createForm(c *gin.Context) {
 		return
 	}
 
+	event := app.FormEventInput{
+		StartsOn:       body.EventStartsOn,
+		EndsOn:         body.EventEndsOn,
+		LocationID:     body.EventLocationId,
+		CalendarInvite: body.CalendarInvite,
+		Reminders:      make([]app.FormReminderInput, len(body.Reminders)),
+	}
+	for i, r := range body.Reminders {
+		event.Reminders[i] = app.FormReminderInput{
+			EmailSlug:     r.EmailSlug,
+			OffsetMinutes: r.OffsetMinutes,
+		}
+	}
+
 	fields := make([]app.FormFieldInput, len(body.Fields))
 	for i, f := range body.Fields {
 		fields[i] = app.FormFieldInput{
@@ -62,11 +76,9 @@ func (h *httpLayer) createForm(c *gin.Context) {
 		}
 	}
 
-	form, err := h.app.CreateForm(user, body.Name, body.Slug, body.OpensOn, body.ClosesOn, body.MaxSubmissions, body.NotOpenMessage, body.ClosedMessage, body.FilledMessage, body.SuccessMessage, body.ConfirmationEmailFieldSlug, body.ConfirmationEmailSlug, body.NotificationEmailTo, body.NotificationEmailSlug, body.ViewableBy, fields)
+	form, err := h.app.CreateForm(user, body.Name, body.Slug, body.OpensOn, body.ClosesOn, body.MaxSubmissions, body.NotOpenMessage, body.ClosedMessage, body.FilledMessage, body.SuccessMessage, body.ConfirmationEmailFieldSlug, body.ConfirmationEmailSlug, body.NotificationEmailTo, body.NotificationEmailSlug, body.ViewableBy, event, fields)
 	if err != nil {
-		if errors.Is(err, app.ErrInvalidNotificationEmail) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notification email address"})
-		} else {
+		if !respondWithValidationError(c, "Invalid form", err) {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create form"})
 		}
 		return
@@ -92,7 +104,14 @@ func (h *httpLayer) createSubmission(c *gin.Context) {
 		return
 	}
 
-	if _, err := h.app.CreateSubmission(slug, values); err != nil {
+	idempotencyKey := c.GetHeader("Idempotency-Key")
+	if len(idempotencyKey) > 255 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key must be at most 255 characters"})
+		return
+	}
+
+	submission, err := h.app.CreateSubmission(slug, values, idempotencyKey)
+	if err != nil {
 		if errors.Is(err, app.ErrFormNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Form not found"})
 		} else if errors.Is(err, app.ErrMissingField) || errors.Is(err, app.ErrInvalidField) {
@@ -103,7 +122,9 @@ func (h *httpLayer) createSubmission(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{})
+	resp := responses.SubmissionReceiptPublic{}
+	resp.Publicize(submission)
+	c.JSON(http.StatusOK, resp)
 }
 
 func (h *httpLayer) deleteForm(c *gin.Context) {
@@ -189,6 +210,40 @@ func (h *httpLayer) getForms(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+func (h *httpLayer) getSubmissionEmails(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	outboundEmails, err := h.app.GetEmailsForSubmission(user, uint(id))
+	if err != nil {
+		if errors.Is(err, app.ErrSubmissionNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Submission not found"})
+		} else if errors.Is(err, app.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve emails"})
+		}
+		return
+	}
+
+	result := make([]responses.OutboundEmailPublic, len(*outboundEmails))
+	for i := range *outboundEmails {
+		result[i].Publicize(&(*outboundEmails)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 func (h *httpLayer) getSubmissions(c *gin.Context) {
 	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
 	user, err := h.app.GetUser(userClaim.ID)
@@ -197,6 +252,25 @@ func (h *httpLayer) getSubmissions(c *gin.Context) {
 		return
 	}
 
+	if reference := c.Query("reference"); len(reference) > 0 {
+		submission, err := h.app.GetSubmissionByReference(user, reference)
+		if err != nil {
+			if errors.Is(err, app.ErrSubmissionNotFound) {
+				c.JSON(http.StatusOK, []responses.SubmissionPublic{})
+			} else if errors.Is(err, app.ErrForbidden) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve submissions"})
+			}
+			return
+		}
+
+		result := []responses.SubmissionPublic{{}}
+		result[0].Publicize(submission)
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
 	formId, err := strconv.ParseUint(c.Query("formId"), 10, 32)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Form ID"})
@@ -247,6 +321,20 @@ func (h *httpLayer) updateForm(c *gin.Context) {
 		return
 	}
 
+	event := app.FormEventInput{
+		StartsOn:       body.EventStartsOn,
+		EndsOn:         body.EventEndsOn,
+		LocationID:     body.EventLocationId,
+		CalendarInvite: body.CalendarInvite,
+		Reminders:      make([]app.FormReminderInput, len(body.Reminders)),
+	}
+	for i, r := range body.Reminders {
+		event.Reminders[i] = app.FormReminderInput{
+			EmailSlug:     r.EmailSlug,
+			OffsetMinutes: r.OffsetMinutes,
+		}
+	}
+
 	fields := make([]app.FormFieldInput, len(body.Fields))
 	for i, f := range body.Fields {
 		fields[i] = app.FormFieldInput{
@@ -260,11 +348,9 @@ func (h *httpLayer) updateForm(c *gin.Context) {
 		}
 	}
 
-	form, err := h.app.UpdateForm(user, uint(id), body.Name, body.Slug, body.OpensOn, body.ClosesOn, body.MaxSubmissions, body.NotOpenMessage, body.ClosedMessage, body.FilledMessage, body.SuccessMessage, body.ConfirmationEmailFieldSlug, body.ConfirmationEmailSlug, body.NotificationEmailTo, body.NotificationEmailSlug, body.ViewableBy, fields)
+	form, err := h.app.UpdateForm(user, uint(id), body.Name, body.Slug, body.OpensOn, body.ClosesOn, body.MaxSubmissions, body.NotOpenMessage, body.ClosedMessage, body.FilledMessage, body.SuccessMessage, body.ConfirmationEmailFieldSlug, body.ConfirmationEmailSlug, body.NotificationEmailTo, body.NotificationEmailSlug, body.ViewableBy, event, fields)
 	if err != nil {
-		if errors.Is(err, app.ErrInvalidNotificationEmail) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notification email address"})
-		} else {
+		if !respondWithValidationError(c, "Invalid form", err) {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to update form"})
 		}
 		return
@@ -98,7 +98,9 @@ func (h *httpLayer) setupFrontendRoutes() {
 	h.engine.StaticFile("/favicon.ico", "./web/favicon.ico")
 	h.engine.StaticFile("/robots.txt", "./web/robots.txt")
 	h.engine.Static("/manage", "./web/manager")
+	h.engine.GET("/events.ics", middleware.Domain(h.config.AssetsDomain), h.getUpcomingEventsCalendar)
 	h.engine.GET("/events/:month", middleware.Domain(h.config.AssetsDomain), h.getEventsForMonth)
+	h.engine.GET("/programs/:program", middleware.Domain(h.config.AssetsDomain), h.getProgramEvents)
 
 	assets := h.engine.Group("/q/")
 	{
@@ -158,8 +160,22 @@ func (h *httpLayer) setupV1ApiRoutes() {
 		}
 		api.POST("/token", middleware.RequestBodyLimit(h.config.MaxJsonBodySize), middleware.RateLimit(h.config.LoginRateLimit, loginRateLimitWindow, h.config.TrustedProxies), h.createToken)
 
+		api.GET("/unsubscribe", h.getUnsubscribe)
+		api.POST("/unsubscribe", middleware.RequestBodyLimit(h.config.MaxJsonBodySize), middleware.RateLimit(h.config.SubmissionRateLimit, submissionRateLimitWindow, h.config.TrustedProxies), h.unsubscribe)
+
+		api.POST("/webhook/email", middleware.RequestBodyLimit(h.config.MaxJsonBodySize), h.receiveEmailWebhook)
+
 		api.PUT("/password", middleware.RequestBodyLimit(h.config.MaxJsonBodySize), middleware.Auth(h.config, true), h.updatePassword)
 
+		api.GET("/events", h.getEvents)
+		api.GET("/events/diagnostics", middleware.Auth(h.config, false), middleware.Permission("event"), h.getEventDiagnostics)
+		api.GET("/events/programs", h.getEventPrograms)
+		api.GET("/events/upcoming", h.getUpcomingEvents)
+		api.POST("/events/refresh", middleware.Auth(h.config, false), middleware.Permission("event"), h.refreshEvents)
+
+		api.GET("/digest", middleware.Auth(h.config, false), h.getDigestPreference)
+		api.PUT("/digest", middleware.RequestBodyLimit(h.config.MaxJsonBodySize), middleware.Auth(h.config, false), h.updateDigestPreference)
+
 		assetApi := api.Group("/asset").Use(middleware.Auth(h.config, false)).Use(middleware.Permission("asset"))
 		{
 			assetApi.GET("", h.getAssets)
@@ -178,6 +194,24 @@ func (h *httpLayer) setupV1ApiRoutes() {
 			redirectApi.DELETE("/:id", h.deleteRedirect)
 		}
 
+		eventApi := api.Group("/event").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("event"))
+		{
+			eventApi.GET("", h.getManagedEvents)
+			eventApi.GET("/location-override", h.getEventLocationOverrides)
+			eventApi.PUT("/location-override", h.setEventLocationOverride)
+			eventApi.DELETE("/location-override", h.deleteEventLocationOverride)
+			eventApi.GET("/:id", h.getEvent)
+			eventApi.POST("", h.createEvent)
+			eventApi.PUT("/:id", h.updateEvent)
+			eventApi.DELETE("/:id", h.deleteEvent)
+		}
+
+		socialApi := api.Group("/social").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("social"))
+		{
+			socialApi.POST("/monthly", h.createMonthlySocialImages)
+			socialApi.POST("/qtbipoc", h.createQtbipocSocialImage)
+		}
+
 		locationApi := api.Group("/location").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("location"))
 		{
 			locationApi.GET("", h.getLocations)
@@ -213,16 +247,54 @@ func (h *httpLayer) setupV1ApiRoutes() {
 			authFormApi.DELETE("/form/:id", h.deleteForm)
 			authFormApi.GET("/submission", h.getSubmissions)
 			authFormApi.DELETE("/submission/:id", h.deleteSubmission)
+			authFormApi.GET("/submission/:id/emails", h.getSubmissionEmails)
 		}
 
 		emailApi := api.Group("/email").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("email"))
 		{
 			emailApi.GET("", h.getEmails)
 			emailApi.GET("/:id", h.getEmail)
+			emailApi.GET("/:id/diff", h.diffEmailRevisions)
+			emailApi.GET("/:id/revision", h.getEmailRevisions)
+			emailApi.GET("/:id/revision/:revision", h.getEmailRevision)
+			emailApi.GET("/:id/used-by", h.getEmailUsage)
 			emailApi.POST("", h.createEmail)
+			emailApi.POST("/:id/preview", h.previewEmail)
+			emailApi.POST("/:id/revision/:revision/restore", h.restoreEmailRevision)
 			emailApi.PUT("/:id", h.updateEmail)
 			emailApi.DELETE("/:id", h.deleteEmail)
 		}
+
+		partialApi := api.Group("/partial").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("email"))
+		{
+			partialApi.GET("", h.getEmailPartials)
+			partialApi.GET("/:id", h.getEmailPartial)
+			partialApi.POST("", h.createEmailPartial)
+			partialApi.PUT("/:id", h.updateEmailPartial)
+			partialApi.DELETE("/:id", h.deleteEmailPartial)
+		}
+
+		broadcastApi := api.Group("/broadcast").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("email"))
+		{
+			broadcastApi.GET("", h.getBroadcasts)
+			broadcastApi.GET("/:id", h.getBroadcast)
+			broadcastApi.POST("", h.createBroadcast)
+			broadcastApi.POST("/preview", h.previewBroadcast)
+		}
+
+		suppressionApi := api.Group("/suppression").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("email"))
+		{
+			suppressionApi.GET("", h.getEmailSuppressions)
+			suppressionApi.POST("", h.createEmailSuppression)
+			suppressionApi.DELETE("/:id", h.deleteEmailSuppression)
+		}
+
+		outboxApi := api.Group("/outbox").Use(middleware.RequestBodyLimit(h.config.MaxJsonBodySize)).Use(middleware.Auth(h.config, false)).Use(middleware.Permission("email"))
+		{
+			outboxApi.GET("", h.getOutboundEmails)
+			outboxApi.GET("/:id", h.getOutboundEmail)
+			outboxApi.POST("/:id/retry", h.retryOutboundEmail)
+		}
 	}
 }
 
@@ -0,0 +1,151 @@
+//
+// Outbound Email Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *httpLayer) getOutboundEmail(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	outboundEmail, err := h.app.GetOutboundEmail(user, uint(id))
+	if errors.Is(err, app.ErrForbidden) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Outbound email not found"})
+		return
+	}
+
+	outboundEmailPublic := responses.OutboundEmailPublic{}
+	outboundEmailPublic.Publicize(outboundEmail)
+
+	c.JSON(http.StatusOK, outboundEmailPublic)
+}
+
+func (h *httpLayer) getOutboundEmails(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	formId, err := optionalIdQuery(c, "formId")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Form ID"})
+		return
+	}
+
+	submissionId, err := optionalIdQuery(c, "submissionId")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Submission ID"})
+		return
+	}
+
+	outboundEmails, err := h.app.GetOutboundEmails(user, c.Query("status"), formId, submissionId, c.Query("recipient"))
+	if errors.Is(err, app.ErrInvalidOutboundEmailStatus) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve outbound emails"})
+		return
+	}
+
+	result := make([]responses.OutboundEmailPublic, len(*outboundEmails))
+	for i := range *outboundEmails {
+		result[i].Publicize(&(*outboundEmails)[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *httpLayer) retryOutboundEmail(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	outboundEmail, err := h.app.RetryOutboundEmail(user, uint(id))
+	if errors.Is(err, app.ErrForbidden) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	} else if errors.Is(err, app.ErrOutboundEmailNotFailed) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Only failed emails can be retried"})
+		return
+	} else if errors.Is(err, app.ErrOutboundEmailNotRendered) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Email never rendered, fix the template and send it again"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Outbound email not found"})
+		return
+	}
+
+	outboundEmailPublic := responses.OutboundEmailPublic{}
+	outboundEmailPublic.Publicize(outboundEmail)
+
+	c.JSON(http.StatusOK, outboundEmailPublic)
+}
+
+func (h *httpLayer) receiveEmailWebhook(c *gin.Context) {
+	bodyBytes, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	if err := h.app.ReceiveEmailWebhook(c.Request.Header, bodyBytes); err != nil {
+		if errors.Is(err, app.ErrInvalidWebhookSignature) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid signature"})
+		} else if errors.Is(err, app.ErrInvalidWebhookPayload) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to record delivery events"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+}
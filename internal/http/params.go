@@ -0,0 +1,89 @@
+//
+// Request Parameters
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/gin-gonic/gin"
+)
+
+// optionalIdQuery reads an ID from the query string, returning nil when the
+// parameter is absent.
+func optionalIdQuery(c *gin.Context, name string) (*uint, error) {
+	value := c.Query(name)
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	result := uint(id)
+	return &result, nil
+}
+
+// optionalDateQuery reads a YYYY-MM-DD query parameter, returning nil when it
+// is absent.
+func optionalDateQuery(c *gin.Context, name string) (*time.Time, error) {
+	value := c.Query(name)
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
+
+// eventFilterQuery reads the category and location query parameters that
+// narrow the events feeds.
+func eventFilterQuery(c *gin.Context) app.EventFilter {
+	return app.EventFilter{
+		Category: c.Query("category"),
+		Location: c.Query("location"),
+	}
+}
+
+// pageQuery reads the limit and offset query parameters, which default to
+// zero when absent.
+func pageQuery(c *gin.Context) (int, int, error) {
+	limit, offset := 0, 0
+
+	var err error
+	if value := c.Query("limit"); len(value) > 0 {
+		if limit, err = strconv.Atoi(value); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if value := c.Query("offset"); len(value) > 0 {
+		if offset, err = strconv.Atoi(value); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return limit, offset, nil
+}
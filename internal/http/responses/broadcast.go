@@ -0,0 +1,68 @@
+//
+// Broadcast Response
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package responses
+
+import "github.com/OutClimb/OutClimb/internal/app/models"
+
+type BroadcastFilterPublic struct {
+	FieldSlug string `json:"fieldSlug"`
+	Value     string `json:"value"`
+}
+
+type BroadcastRequestPublic struct {
+	EmailId uint                    `json:"emailId"`
+	FormId  uint                    `json:"formId"`
+	Filters []BroadcastFilterPublic `json:"filters"`
+}
+
+type BroadcastPreviewPublic struct {
+	RecipientCount int `json:"recipientCount"`
+}
+
+type BroadcastPublic struct {
+	Id             uint                    `json:"id"`
+	CreatedAt      int64                   `json:"createdAt"`
+	CreatedBy      string                  `json:"createdBy"`
+	EmailSlug      string                  `json:"emailSlug"`
+	FormId         uint                    `json:"formId"`
+	Filters        []BroadcastFilterPublic `json:"filters"`
+	RecipientCount uint                    `json:"recipientCount"`
+	Pending        int64                   `json:"pending"`
+	Sent           int64                   `json:"sent"`
+	Failed         int64                   `json:"failed"`
+	Suppressed     int64                   `json:"suppressed"`
+}
+
+func (b *BroadcastPublic) Publicize(broadcast *models.BroadcastInternal) {
+	b.Id = broadcast.ID
+	b.CreatedAt = broadcast.CreatedAt.UnixMilli()
+	b.CreatedBy = broadcast.CreatedBy
+	b.EmailSlug = broadcast.EmailSlug
+	b.FormId = broadcast.FormID
+	b.RecipientCount = broadcast.RecipientCount
+
+	b.Filters = make([]BroadcastFilterPublic, len(broadcast.Filters))
+	for i, filter := range broadcast.Filters {
+		b.Filters[i] = BroadcastFilterPublic{FieldSlug: filter.FieldSlug, Value: filter.Value}
+	}
+
+	b.Pending = broadcast.Pending
+	b.Sent = broadcast.Sent
+	b.Failed = broadcast.Failed
+	b.Suppressed = broadcast.Suppressed
+}
@@ -20,19 +20,111 @@ package responses
 import "github.com/OutClimb/OutClimb/internal/app/models"
 
 type EmailPublic struct {
-	Id       uint   `json:"id"`
-	Name     string `json:"name"`
-	Slug     string `json:"slug"`
-	Subject  string `json:"subject"`
-	HtmlBody string `json:"htmlBody"`
-	TextBody string `json:"textBody"`
+	Id           uint    `json:"id"`
+	Name         string  `json:"name"`
+	Slug         string  `json:"slug"`
+	LayoutSlug   *string `json:"layoutSlug"`
+	Subject      string  `json:"subject"`
+	MarkdownBody *string `json:"markdownBody"`
+	HtmlBody     string  `json:"htmlBody"`
+	TextBody     string  `json:"textBody"`
+	Revision     uint    `json:"revision"`
 }
 
 func (e *EmailPublic) Publicize(email *models.EmailInternal) {
 	e.Id = email.ID
 	e.Name = email.Name
 	e.Slug = email.Slug
+	e.LayoutSlug = email.LayoutSlug
 	e.Subject = email.Subject
+	e.MarkdownBody = email.MarkdownBody
 	e.HtmlBody = email.HtmlBody
 	e.TextBody = email.TextBody
+	e.Revision = email.Revision
+}
+
+type EmailRevisionPublic struct {
+	EmailId      uint    `json:"emailId"`
+	Revision     uint    `json:"revision"`
+	CreatedAt    int64   `json:"createdAt"`
+	CreatedBy    string  `json:"createdBy"`
+	Name         string  `json:"name"`
+	Slug         string  `json:"slug"`
+	LayoutSlug   *string `json:"layoutSlug"`
+	Subject      string  `json:"subject"`
+	MarkdownBody *string `json:"markdownBody"`
+	HtmlBody     string  `json:"htmlBody"`
+	TextBody     string  `json:"textBody"`
+}
+
+func (e *EmailRevisionPublic) Publicize(revision *models.EmailRevisionInternal) {
+	e.EmailId = revision.EmailID
+	e.Revision = revision.Revision
+	e.CreatedAt = revision.CreatedAt.UnixMilli()
+	e.CreatedBy = revision.CreatedBy
+	e.Name = revision.Name
+	e.Slug = revision.Slug
+	e.LayoutSlug = revision.LayoutSlug
+	e.Subject = revision.Subject
+	e.MarkdownBody = revision.MarkdownBody
+	e.HtmlBody = revision.HtmlBody
+	e.TextBody = revision.TextBody
+}
+
+type EmailDiffLinePublic struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type EmailFieldDiffPublic struct {
+	Field string                `json:"field"`
+	Lines []EmailDiffLinePublic `json:"lines"`
+}
+
+type EmailRevisionDiffPublic struct {
+	From   uint                   `json:"from"`
+	To     uint                   `json:"to"`
+	Fields []EmailFieldDiffPublic `json:"fields"`
+}
+
+func (e *EmailRevisionDiffPublic) Publicize(diff *models.EmailRevisionDiffInternal) {
+	e.From = diff.From
+	e.To = diff.To
+	e.Fields = make([]EmailFieldDiffPublic, len(diff.Fields))
+	for i, field := range diff.Fields {
+		e.Fields[i].Field = field.Field
+		e.Fields[i].Lines = make([]EmailDiffLinePublic, len(field.Lines))
+		for j, line := range field.Lines {
+			e.Fields[i].Lines[j] = EmailDiffLinePublic{Op: line.Op, Text: line.Text}
+		}
+	}
+}
+
+type EmailUsagePublic struct {
+	FormId   uint   `json:"formId"`
+	FormName string `json:"formName"`
+	FormSlug string `json:"formSlug"`
+	Usage    string `json:"usage"`
+}
+
+func (e *EmailUsagePublic) Publicize(usage *models.EmailUsageInternal) {
+	e.FormId = usage.FormID
+	e.FormName = usage.FormName
+	e.FormSlug = usage.FormSlug
+	e.Usage = usage.Usage
+}
+
+type EmailPreviewRequestPublic struct {
+	FormId       uint              `json:"formId"`
+	SubmissionId *uint             `json:"submissionId"`
+	Values       map[string]string `json:"values"`
+	SendTest     bool              `json:"sendTest"`
+}
+
+type EmailPreviewPublic struct {
+	Subject    string                    `json:"subject"`
+	HtmlBody   string                    `json:"htmlBody"`
+	TextBody   string                    `json:"textBody"`
+	Errors     []ValidationProblemPublic `json:"errors"`
+	TestQueued bool                      `json:"testQueued"`
 }
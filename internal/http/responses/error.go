@@ -26,3 +26,21 @@ func Error(err string) *error {
 		Error: &err,
 	}
 }
+
+type ValidationProblemPublic struct {
+	Field   string `json:"field"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+type validationError struct {
+	Error    *string                   `json:"error"`
+	Problems []ValidationProblemPublic `json:"problems"`
+}
+
+func ValidationError(err string, problems []ValidationProblemPublic) *validationError {
+	return &validationError{
+		Error:    &err,
+		Problems: problems,
+	}
+}
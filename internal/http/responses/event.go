@@ -1,6 +1,6 @@
 //
 // Event Response
-// Copyright 2026 OutClimb
+// Copyright 2025 OutClimb
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -17,65 +17,36 @@
 
 package responses
 
-import (
-	"encoding/xml"
-
-	"github.com/OutClimb/OutClimb/internal/app/models"
-)
-
-type EventFeedPublic struct {
-	XMLName xml.Name           `xml:"rss"`
-	Version string             `xml:"version,attr"`
-	Channel eventChannelPublic `xml:"channel"`
-}
-
-type eventChannelPublic struct {
-	Title       string        `xml:"title"`
-	Link        string        `xml:"link"`
-	Description string        `xml:"description"`
-	Items       []EventPublic `xml:"item"`
-}
-
-// cdataContent wraps a string so it marshals as a CDATA section.
-type cdataContent struct {
-	Inner []byte `xml:",innerxml"`
-}
-
-type mediaContentPublic struct {
-	URL  string `xml:"url,attr"`
-	Type string `xml:"type,attr,omitempty"`
-}
+import "github.com/OutClimb/OutClimb/internal/app/models"
 
 type EventPublic struct {
-	Title          string              `xml:"title"`
-	Link           string              `xml:"link"`
-	Description    string              `xml:"description"`
-	PubDate        string              `xml:"pubDate"`
-	GUID           string              `xml:"guid"`
-	ContentEncoded *cdataContent       `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
-	MediaContent   *mediaContentPublic `xml:"http://www.rssboard.org/media-rss content"`
+	Id           uint     `json:"id"`
+	Title        string   `json:"title"`
+	StartsOn     int64    `json:"startsOn"`
+	EndsOn       *int64   `json:"endsOn,omitempty"`
+	LocationId   *uint    `json:"locationId,omitempty"`
+	ImageAssetId *uint    `json:"imageAssetId,omitempty"`
+	Description  string   `json:"description"`
+	FormId       *uint    `json:"formId,omitempty"`
+	Link         string   `json:"link"`
+	Published    bool     `json:"published"`
+	Categories   []string `json:"categories"`
 }
 
-func (f *EventFeedPublic) Publicize(feed *models.EventFeedInternal) {
-	f.Version = "2.0"
-	f.Channel.Title = feed.Title
-	f.Channel.Link = feed.Link
-	f.Channel.Description = feed.Description
-	f.Channel.Items = make([]EventPublic, 0, len(feed.Events))
-	for _, event := range feed.Events {
-		ep := EventPublic{
-			Title:       event.Title,
-			Link:        event.Link,
-			Description: event.Description,
-			PubDate:     event.PubDate,
-			GUID:        event.GUID,
-		}
-		if event.ContentEncoded != "" {
-			ep.ContentEncoded = &cdataContent{Inner: []byte("<![CDATA[" + event.ContentEncoded + "]]>")}
-		}
-		if event.MediaURL != "" {
-			ep.MediaContent = &mediaContentPublic{URL: event.MediaURL, Type: event.MediaType}
-		}
-		f.Channel.Items = append(f.Channel.Items, ep)
+func (e *EventPublic) Publicize(event *models.EventInternal) {
+	e.Id = event.ID
+	e.Title = event.Title
+	e.StartsOn = event.StartsAt.UnixMilli()
+	e.LocationId = event.LocationID
+	e.ImageAssetId = event.ImageAssetID
+	e.Description = event.Description
+	e.FormId = event.FormID
+	e.Link = event.Link
+	e.Published = event.Published
+	e.Categories = event.Categories
+
+	if event.EndsAt != nil {
+		endsOn := event.EndsAt.UnixMilli()
+		e.EndsOn = &endsOn
 	}
 }
@@ -0,0 +1,237 @@
+//
+// Event Feed Response
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package responses
+
+import (
+	"encoding/xml"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+)
+
+type EventFeedPublic struct {
+	XMLName xml.Name           `xml:"rss"`
+	Version string             `xml:"version,attr"`
+	Channel eventChannelPublic `xml:"channel"`
+}
+
+type eventChannelPublic struct {
+	Title       string            `xml:"title"`
+	Link        string            `xml:"link"`
+	Description string            `xml:"description"`
+	Items       []FeedEventPublic `xml:"item"`
+}
+
+// cdataContent wraps a string so it marshals as a CDATA section.
+type cdataContent struct {
+	Inner []byte `xml:",innerxml"`
+}
+
+type mediaContentPublic struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type FeedEventPublic struct {
+	Title          string              `xml:"title"`
+	Link           string              `xml:"link"`
+	Description    string              `xml:"description"`
+	PubDate        string              `xml:"pubDate"`
+	GUID           string              `xml:"guid"`
+	Categories     []string            `xml:"category"`
+	ContentEncoded *cdataContent       `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	MediaContent   *mediaContentPublic `xml:"http://www.rssboard.org/media-rss content"`
+	Location       *feedLocationPublic `xml:"https://outclimb.gay/ns/events location"`
+}
+
+// feedLocationPublic is where an event is, in OutClimb's own namespace as RSS
+// has no element for it.
+type feedLocationPublic struct {
+	ID      uint   `xml:"id,attr"`
+	Match   string `xml:"match,attr,omitempty"`
+	Name    string `xml:"name"`
+	Address string `xml:"address,omitempty"`
+	Color   string `xml:"color,omitempty"`
+	Image   string `xml:"image,omitempty"`
+}
+
+func (f *EventFeedPublic) Publicize(feed *models.EventFeedInternal) {
+	f.Version = "2.0"
+	f.Channel.Title = feed.Title
+	f.Channel.Link = feed.Link
+	f.Channel.Description = feed.Description
+	f.Channel.Items = make([]FeedEventPublic, 0, len(feed.Events))
+	for _, event := range feed.Events {
+		ep := FeedEventPublic{
+			Title:       event.Title,
+			Link:        event.Link,
+			Description: event.Description,
+			PubDate:     event.PubDate,
+			GUID:        event.GUID,
+			Categories:  event.Categories,
+		}
+		if event.ContentEncoded != "" {
+			ep.ContentEncoded = &cdataContent{Inner: []byte("<![CDATA[" + event.ContentEncoded + "]]>")}
+		}
+		if event.MediaURL != "" {
+			ep.MediaContent = &mediaContentPublic{URL: event.MediaURL, Type: event.MediaType}
+		}
+		if event.Location != nil {
+			ep.Location = &feedLocationPublic{
+				ID:      event.Location.ID,
+				Match:   event.LocationMatch,
+				Name:    event.Location.Name,
+				Address: event.Location.Address,
+				Color:   event.Location.Color,
+				Image:   event.LocationImageURL,
+			}
+		}
+		f.Channel.Items = append(f.Channel.Items, ep)
+	}
+}
+
+type EventRefreshPublic struct {
+	Events    int   `json:"events"`
+	FetchedAt int64 `json:"fetchedAt"`
+}
+
+func (r *EventRefreshPublic) Publicize(feed *models.EventFeedInternal) {
+	r.Events = len(feed.Events)
+	r.FetchedAt = feed.FetchedAt.UnixMilli()
+}
+
+type EventLocationDisplay struct {
+	Id                 uint   `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	Color              string `json:"color"`
+	BackgroundImageURL string `json:"backgroundImageUrl,omitempty"`
+}
+
+type EventDisplay struct {
+	Title         string                `json:"title"`
+	Link          string                `json:"link"`
+	GUID          string                `json:"guid"`
+	Date          string                `json:"date"`
+	StartsAt      *int64                `json:"startsAt,omitempty"`
+	EndsAt        *int64                `json:"endsAt,omitempty"`
+	ImageURL      string                `json:"imageUrl,omitempty"`
+	ImageType     string                `json:"imageType,omitempty"`
+	Description   string                `json:"description"`
+	Content       string                `json:"content"`
+	Categories    []string              `json:"categories"`
+	Location      *EventLocationDisplay `json:"location,omitempty"`
+	LocationMatch string                `json:"locationMatch,omitempty"`
+}
+
+func (e *EventDisplay) Publicize(event *models.FeedEventInternal) {
+	e.Title = event.Title
+	e.Link = event.Link
+	e.GUID = event.GUID
+	e.Date = event.EventDate.Format("2006-01-02")
+	e.ImageURL = event.MediaURL
+	e.ImageType = event.MediaType
+	e.Description = event.PlainDescription
+	e.Content = event.ContentEncoded
+	e.Categories = event.Categories
+	if e.Categories == nil {
+		e.Categories = []string{}
+	}
+
+	if event.StartsAt != nil {
+		startsAt := event.StartsAt.UnixMilli()
+		e.StartsAt = &startsAt
+	}
+
+	if event.EndsAt != nil {
+		endsAt := event.EndsAt.UnixMilli()
+		e.EndsAt = &endsAt
+	}
+
+	if event.Location != nil {
+		e.Location = &EventLocationDisplay{
+			Id:                 event.Location.ID,
+			Name:               event.Location.Name,
+			Address:            event.Location.Address,
+			Color:              event.Location.Color,
+			BackgroundImageURL: event.LocationImageURL,
+		}
+		e.LocationMatch = event.LocationMatch
+	}
+}
+
+type EventPageDisplay struct {
+	Events []EventDisplay `json:"events"`
+	Total  int            `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+func (p *EventPageDisplay) Publicize(page *models.EventPageInternal) {
+	p.Total = page.Total
+	p.Limit = page.Limit
+	p.Offset = page.Offset
+	p.Events = make([]EventDisplay, len(page.Events))
+	for i, event := range page.Events {
+		p.Events[i].Publicize(event)
+	}
+}
+
+type SkippedEventPublic struct {
+	Title  string `json:"title"`
+	Link   string `json:"link"`
+	GUID   string `json:"guid"`
+	Reason string `json:"reason"`
+}
+
+type EventDiagnosticsPublic struct {
+	FetchedAt int64                `json:"fetchedAt"`
+	Events    int                  `json:"events"`
+	Sources   map[string]int       `json:"sources"`
+	Skipped   []SkippedEventPublic `json:"skipped"`
+}
+
+func (d *EventDiagnosticsPublic) Publicize(diagnostics *models.EventDiagnosticsInternal) {
+	d.FetchedAt = diagnostics.FetchedAt.UnixMilli()
+	d.Events = diagnostics.Events
+	d.Sources = diagnostics.Sources
+	d.Skipped = make([]SkippedEventPublic, len(diagnostics.Skipped))
+	for i, skipped := range diagnostics.Skipped {
+		d.Skipped[i] = SkippedEventPublic{
+			Title:  skipped.Title,
+			Link:   skipped.Link,
+			GUID:   skipped.GUID,
+			Reason: skipped.Reason,
+		}
+	}
+}
+
+type EventProgramPublic struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Events      int    `json:"events"`
+	FeedURL     string `json:"feedUrl,omitempty"`
+	CalendarURL string `json:"calendarUrl,omitempty"`
+}
+
+func (p *EventProgramPublic) Publicize(program *models.EventProgramInternal) {
+	p.Name = program.Name
+	p.Slug = program.Slug
+	p.Events = program.Events
+	p.FeedURL = program.FeedURL
+	p.CalendarURL = program.CalendarURL
+}
@@ -20,22 +20,27 @@ package responses
 import "github.com/OutClimb/OutClimb/internal/app/models"
 
 type FormPublic struct {
-	Id                         uint              `json:"id"`
-	Name                       string            `json:"name"`
-	Slug                       string            `json:"slug"`
-	OpensOn                    *int64            `json:"opensOn,omitempty"`
-	ClosesOn                   *int64            `json:"closesOn,omitempty"`
-	MaxSubmissions             *uint             `json:"maxSubmissions,omitempty"`
-	NotOpenMessage             *string           `json:"notOpenMessage,omitempty"`
-	ClosedMessage              *string           `json:"closedMessage,omitempty"`
-	FilledMessage              *string           `json:"filledMessage,omitempty"`
-	SuccessMessage             *string           `json:"successMessage,omitempty"`
-	ConfirmationEmailFieldSlug *string           `json:"confirmationEmailFieldSlug,omitempty"`
-	ConfirmationEmailSlug      *string           `json:"confirmationEmailSlug,omitempty"`
-	NotificationEmailTo        *string           `json:"notificationEmailTo,omitempty"`
-	NotificationEmailSlug      *string           `json:"notificationEmailSlug,omitempty"`
-	ViewableBy                 []uint            `json:"viewableBy"`
-	Fields                     []FormFieldPublic `json:"fields"`
+	Id                         uint                 `json:"id"`
+	Name                       string               `json:"name"`
+	Slug                       string               `json:"slug"`
+	OpensOn                    *int64               `json:"opensOn,omitempty"`
+	ClosesOn                   *int64               `json:"closesOn,omitempty"`
+	MaxSubmissions             *uint                `json:"maxSubmissions,omitempty"`
+	NotOpenMessage             *string              `json:"notOpenMessage,omitempty"`
+	ClosedMessage              *string              `json:"closedMessage,omitempty"`
+	FilledMessage              *string              `json:"filledMessage,omitempty"`
+	SuccessMessage             *string              `json:"successMessage,omitempty"`
+	ConfirmationEmailFieldSlug *string              `json:"confirmationEmailFieldSlug,omitempty"`
+	ConfirmationEmailSlug      *string              `json:"confirmationEmailSlug,omitempty"`
+	NotificationEmailTo        *string              `json:"notificationEmailTo,omitempty"`
+	NotificationEmailSlug      *string              `json:"notificationEmailSlug,omitempty"`
+	EventStartsOn              *int64               `json:"eventStartsOn,omitempty"`
+	EventEndsOn                *int64               `json:"eventEndsOn,omitempty"`
+	EventLocationId            *uint                `json:"eventLocationId,omitempty"`
+	CalendarInvite             bool                 `json:"calendarInvite"`
+	Reminders                  []FormReminderPublic `json:"reminders"`
+	ViewableBy                 []uint               `json:"viewableBy"`
+	Fields                     []FormFieldPublic    `json:"fields"`
 }
 
 func (f *FormPublic) Publicize(form *models.FormInternal) {
@@ -51,6 +56,8 @@ func (f *FormPublic) Publicize(form *models.FormInternal) {
 	f.ConfirmationEmailSlug = form.ConfirmationEmailSlug
 	f.NotificationEmailTo = form.NotificationEmailTo
 	f.NotificationEmailSlug = form.NotificationEmailSlug
+	f.EventLocationId = form.EventLocationID
+	f.CalendarInvite = form.CalendarInvite
 	f.ViewableBy = form.ViewableBy
 
 	if form.OpensOn != nil {
@@ -63,12 +70,37 @@ func (f *FormPublic) Publicize(form *models.FormInternal) {
 		f.ClosesOn = &closesOn
 	}
 
+	if form.EventStartsAt != nil {
+		eventStartsOn := form.EventStartsAt.UnixMilli()
+		f.EventStartsOn = &eventStartsOn
+	}
+
+	if form.EventEndsAt != nil {
+		eventEndsOn := form.EventEndsAt.UnixMilli()
+		f.EventEndsOn = &eventEndsOn
+	}
+
+	f.Reminders = make([]FormReminderPublic, len(form.Reminders))
+	for i := range form.Reminders {
+		f.Reminders[i].Publicize(&form.Reminders[i])
+	}
+
 	f.Fields = make([]FormFieldPublic, len(form.Fields))
 	for i := range form.Fields {
 		f.Fields[i].Publicize(&form.Fields[i])
 	}
 }
 
+type FormReminderPublic struct {
+	EmailSlug     string `json:"emailSlug"`
+	OffsetMinutes int    `json:"offsetMinutes"`
+}
+
+func (r *FormReminderPublic) Publicize(reminder *models.FormReminderInternal) {
+	r.EmailSlug = reminder.EmailSlug
+	r.OffsetMinutes = reminder.OffsetMinutes
+}
+
 type FormDisplay struct {
 	Id             uint               `json:"id"`
 	Name           string             `json:"name"`
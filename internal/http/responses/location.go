@@ -17,19 +17,26 @@
 
 package responses
 
-import "github.com/OutClimb/OutClimb/internal/app/models"
+import (
+	"strings"
+
+	"github.com/OutClimb/OutClimb/internal/app/models"
+)
 
 type LocationPublic struct {
-	Id                  uint   `json:"id"`
-	Name                string `json:"name"`
-	MainImageName       string `json:"mainImageName"`
-	IndividualImageName string `json:"individualImageName"`
-	BackgroundImagePath string `json:"backgroundImagePath"`
-	Color               string `json:"color"`
-	Address             string `json:"address"`
-	StartTime           string `json:"startTime"`
-	EndTime             string `json:"endTime"`
-	Description         string `json:"description"`
+	Id                  uint     `json:"id"`
+	Name                string   `json:"name"`
+	MainImageName       string   `json:"mainImageName"`
+	IndividualImageName string   `json:"individualImageName"`
+	BackgroundImagePath string   `json:"backgroundImagePath"`
+	Color               string   `json:"color"`
+	Address             string   `json:"address"`
+	StartTime           string   `json:"startTime"`
+	EndTime             string   `json:"endTime"`
+	Description         string   `json:"description"`
+	Latitude            *float64 `json:"latitude,omitempty"`
+	Longitude           *float64 `json:"longitude,omitempty"`
+	Keywords            string   `json:"keywords"`
 }
 
 func (l *LocationPublic) Publicize(location *models.LocationInternal) {
@@ -43,4 +50,7 @@ func (l *LocationPublic) Publicize(location *models.LocationInternal) {
 	l.StartTime = location.StartTime
 	l.EndTime = location.EndTime
 	l.Description = location.Description
+	l.Latitude = location.Latitude
+	l.Longitude = location.Longitude
+	l.Keywords = strings.Join(location.Keywords, "\n")
 }
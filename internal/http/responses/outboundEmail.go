@@ -0,0 +1,104 @@
+//
+// Outbound Email Response
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package responses
+
+import "github.com/OutClimb/OutClimb/internal/app/models"
+
+type OutboundEmailPublic struct {
+	Id                uint     `json:"id"`
+	CreatedAt         int64    `json:"createdAt"`
+	UpdatedAt         int64    `json:"updatedAt"`
+	EmailSlug         string   `json:"emailSlug"`
+	EmailRevision     uint     `json:"emailRevision"`
+	FormId            *uint    `json:"formId"`
+	SubmissionId      *uint    `json:"submissionId"`
+	BroadcastId       *uint    `json:"broadcastId"`
+	To                []string `json:"to"`
+	Subject           string   `json:"subject"`
+	HtmlBody          string   `json:"htmlBody"`
+	TextBody          string   `json:"textBody"`
+	Status            string   `json:"status"`
+	Attempts          uint     `json:"attempts"`
+	ProviderMessageId string   `json:"providerMessageId"`
+	NextAttemptAt     int64    `json:"nextAttemptAt"`
+	LastAttemptAt     int64    `json:"lastAttemptAt"`
+	LastError         string   `json:"lastError"`
+	SentAt            int64    `json:"sentAt"`
+	DeliveredAt       int64    `json:"deliveredAt"`
+	OpenedAt          int64    `json:"openedAt"`
+	BouncedAt         int64    `json:"bouncedAt"`
+	ComplainedAt      int64    `json:"complainedAt"`
+}
+
+func (o *OutboundEmailPublic) Publicize(outboundEmail *models.OutboundEmailInternal) {
+	o.Id = outboundEmail.ID
+	o.CreatedAt = outboundEmail.CreatedAt.UnixMilli()
+	o.UpdatedAt = outboundEmail.UpdatedAt.UnixMilli()
+	o.EmailSlug = outboundEmail.EmailSlug
+	o.EmailRevision = outboundEmail.EmailRevision
+	o.FormId = outboundEmail.FormID
+	o.SubmissionId = outboundEmail.SubmissionID
+	o.BroadcastId = outboundEmail.BroadcastID
+	o.To = outboundEmail.To
+	o.Subject = outboundEmail.Subject
+	o.HtmlBody = outboundEmail.HtmlBody
+	o.TextBody = outboundEmail.TextBody
+	o.Status = outboundEmail.Status
+	o.Attempts = outboundEmail.Attempts
+	o.NextAttemptAt = outboundEmail.NextAttemptAt.UnixMilli()
+
+	o.ProviderMessageId = ""
+	if outboundEmail.ProviderMessageID != nil {
+		o.ProviderMessageId = *outboundEmail.ProviderMessageID
+	}
+
+	o.LastAttemptAt = 0
+	if outboundEmail.LastAttemptAt != nil {
+		o.LastAttemptAt = outboundEmail.LastAttemptAt.UnixMilli()
+	}
+
+	o.LastError = ""
+	if outboundEmail.LastError != nil {
+		o.LastError = *outboundEmail.LastError
+	}
+
+	o.SentAt = 0
+	if outboundEmail.SentAt != nil {
+		o.SentAt = outboundEmail.SentAt.UnixMilli()
+	}
+
+	o.DeliveredAt = 0
+	if outboundEmail.DeliveredAt != nil {
+		o.DeliveredAt = outboundEmail.DeliveredAt.UnixMilli()
+	}
+
+	o.OpenedAt = 0
+	if outboundEmail.OpenedAt != nil {
+		o.OpenedAt = outboundEmail.OpenedAt.UnixMilli()
+	}
+
+	o.BouncedAt = 0
+	if outboundEmail.BouncedAt != nil {
+		o.BouncedAt = outboundEmail.BouncedAt.UnixMilli()
+	}
+
+	o.ComplainedAt = 0
+	if outboundEmail.ComplainedAt != nil {
+		o.ComplainedAt = outboundEmail.ComplainedAt.UnixMilli()
+	}
+}
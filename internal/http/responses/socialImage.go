@@ -0,0 +1,61 @@
+//
+// Social Image Response
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package responses
+
+import "github.com/OutClimb/OutClimb/internal/app/models"
+
+// Days are written YYYY-MM-DD and read in the configured timezone.
+
+type SocialImageEventRequestPublic struct {
+	Day         string `json:"day"`
+	StartTime   string `json:"startTime"`
+	EndTime     string `json:"endTime"`
+	LocationId  uint   `json:"locationId"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+}
+
+type MonthlySocialImageRequestPublic struct {
+	Year   int                             `json:"year"`
+	Month  int                             `json:"month"`
+	Events []SocialImageEventRequestPublic `json:"events"`
+	Save   bool                            `json:"save"`
+}
+
+type QtbipocSocialImageRequestPublic struct {
+	Day             string `json:"day"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	WhenDescription string `json:"whenDescription"`
+	LocationId      uint   `json:"locationId"`
+	Cost            string `json:"cost"`
+	Save            bool   `json:"save"`
+}
+
+type SocialImagesPublic struct {
+	FileName string                `json:"fileName"`
+	Assets   []AssetResponsePublic `json:"assets"`
+}
+
+func (s *SocialImagesPublic) Publicize(images *models.SocialImagesInternal) {
+	s.FileName = images.FileName
+	s.Assets = make([]AssetResponsePublic, len(images.Assets))
+	for i := range images.Assets {
+		s.Assets[i].Publicize(&images.Assets[i])
+	}
+}
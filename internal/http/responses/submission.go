@@ -27,12 +27,16 @@ type SubmissionValuePublic struct {
 
 type SubmissionPublic struct {
 	Id          uint                    `json:"id"`
+	FormId      uint                    `json:"formId"`
+	Reference   string                  `json:"reference"`
 	SubmittedOn int64                   `json:"submittedOn"`
 	Values      []SubmissionValuePublic `json:"values"`
 }
 
 func (s *SubmissionPublic) Publicize(submission *models.SubmissionInternal) {
 	s.Id = submission.ID
+	s.FormId = submission.FormID
+	s.Reference = submission.Reference
 	s.SubmittedOn = submission.SubmittedOn.UnixMilli()
 
 	s.Values = make([]SubmissionValuePublic, len(submission.Values))
@@ -46,3 +50,11 @@ func (s *SubmissionPublic) Publicize(submission *models.SubmissionInternal) {
 }
 
 type SubmissionCreateRequest map[string]string
+
+type SubmissionReceiptPublic struct {
+	Reference string `json:"reference"`
+}
+
+func (s *SubmissionReceiptPublic) Publicize(submission *models.SubmissionInternal) {
+	s.Reference = submission.Reference
+}
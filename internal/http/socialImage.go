@@ -0,0 +1,167 @@
+//
+// Social Image Routes
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/app/models"
+	"github.com/OutClimb/OutClimb/internal/http/middleware"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *httpLayer) createMonthlySocialImages(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Get the body data
+	bodyAsByteArray, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	// Parse the body data
+	body := responses.MonthlySocialImageRequestPublic{}
+	err = json.Unmarshal(bodyAsByteArray, &body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	input := app.MonthlySocialImageInput{
+		Year:   body.Year,
+		Month:  time.Month(body.Month),
+		Events: make([]app.SocialImageEventInput, len(body.Events)),
+		Save:   body.Save,
+	}
+	for i, event := range body.Events {
+		day, err := time.Parse("2006-01-02", event.Day)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event day, expected YYYY-MM-DD"})
+			return
+		}
+
+		input.Events[i] = app.SocialImageEventInput{
+			Day:         day,
+			StartTime:   event.StartTime,
+			EndTime:     event.EndTime,
+			LocationID:  event.LocationId,
+			Address:     event.Address,
+			Description: event.Description,
+		}
+	}
+
+	images, err := h.app.RenderMonthlySocialImages(user, input)
+	if respondWithSocialImageError(c, err) {
+		return
+	}
+
+	respondWithSocialImages(c, images, body.Save)
+}
+
+func (h *httpLayer) createQtbipocSocialImage(c *gin.Context) {
+	userClaim, _ := c.MustGet("user").(middleware.JwtUserClaim)
+	user, err := h.app.GetUser(userClaim.ID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Get the body data
+	bodyAsByteArray, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to retrieve request body"})
+		return
+	}
+
+	// Parse the body data
+	body := responses.QtbipocSocialImageRequestPublic{}
+	err = json.Unmarshal(bodyAsByteArray, &body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to parse request body"})
+		return
+	}
+
+	day, err := time.Parse("2006-01-02", body.Day)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid day, expected YYYY-MM-DD"})
+		return
+	}
+
+	images, err := h.app.RenderQtbipocSocialImage(user, app.QtbipocSocialImageInput{
+		Day:             day,
+		StartTime:       body.StartTime,
+		EndTime:         body.EndTime,
+		WhenDescription: body.WhenDescription,
+		LocationID:      body.LocationId,
+		Cost:            body.Cost,
+		Save:            body.Save,
+	})
+	if respondWithSocialImageError(c, err) {
+		return
+	}
+
+	respondWithSocialImages(c, images, body.Save)
+}
+
+func respondWithSocialImageError(c *gin.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, app.ErrForbidden) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Saving social images needs permission to create assets"})
+	} else if errors.Is(err, app.ErrSocialBackgroundNotFound) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	} else if !respondWithValidationError(c, "Invalid social image", err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to create social images"})
+	}
+
+	return true
+}
+
+// respondWithSocialImages lists the saved assets when the images were saved,
+// and otherwise sends the image, or a ZIP of the images, as a download.
+func respondWithSocialImages(c *gin.Context, images *models.SocialImagesInternal, saved bool) {
+	if saved {
+		imagesPublic := responses.SocialImagesPublic{}
+		imagesPublic.Publicize(images)
+
+		c.JSON(http.StatusOK, imagesPublic)
+		return
+	}
+
+	contentType, data := "application/zip", images.Archive
+	if len(images.Images) == 1 {
+		contentType, data = "image/png", images.Images[0].Data
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": images.FileName}))
+	c.Data(http.StatusOK, contentType, data)
+}
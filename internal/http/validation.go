@@ -0,0 +1,51 @@
+//
+// Validation Responses
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/OutClimb/OutClimb/internal/app"
+	"github.com/OutClimb/OutClimb/internal/http/responses"
+	"github.com/gin-gonic/gin"
+)
+
+// respondWithValidationError writes a 400 listing every problem when err is an
+// app.ValidationError. It returns false, writing nothing, for any other error.
+func respondWithValidationError(c *gin.Context, message string, err error) bool {
+	var validationErr *app.ValidationError
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+
+	c.JSON(http.StatusBadRequest, responses.ValidationError(message, publicizeValidationProblems(validationErr.Problems)))
+	return true
+}
+
+func publicizeValidationProblems(problems []app.ValidationProblem) []responses.ValidationProblemPublic {
+	result := make([]responses.ValidationProblemPublic, len(problems))
+	for i, p := range problems {
+		result[i] = responses.ValidationProblemPublic{
+			Field:   p.Field,
+			Line:    p.Line,
+			Message: p.Message,
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+//
+// File Mailer
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// fileMailer writes every message to an .eml file instead of sending it, so
+// local development can inspect exactly what would have gone out.
+type fileMailer struct {
+	directory string
+}
+
+func NewFileMailer(directory string) (*fileMailer, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &fileMailer{
+		directory: directory,
+	}, nil
+}
+
+func (m *fileMailer) Send(msg *Message) (string, error) {
+	messageId, err := newMessageId(msg.From)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := buildMIME(msg, messageId)
+	if err != nil {
+		return "", err
+	}
+
+	name := time.Now().UTC().Format("20060102T150405.000000000") + "-" + strings.Trim(strings.SplitN(messageId, "@", 2)[0], "<") + ".eml"
+	if err := os.WriteFile(filepath.Join(m.directory, name), body, 0o644); err != nil {
+		return "", err
+	}
+
+	return messageId, nil
+}
@@ -0,0 +1,81 @@
+//
+// Fixture Webhooks
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+)
+
+// FixtureSignatureHeader carries the hex HMAC-SHA256 of the body, keyed with
+// the webhook secret.
+const FixtureSignatureHeader = "X-Webhook-Signature"
+
+// fixtureWebhookReceiver accepts a JSON array of DeliveryEvent as is. It backs
+// the transports that have no provider, so a local server can replay fixture
+// events against messages written by the file or memory transport.
+type fixtureWebhookReceiver struct {
+	secret []byte
+}
+
+func NewFixtureWebhookReceiver(secret string) *fixtureWebhookReceiver {
+	return &fixtureWebhookReceiver{
+		secret: []byte(secret),
+	}
+}
+
+// SignFixture returns the signature header value for body.
+func SignFixture(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (r *fixtureWebhookReceiver) Parse(header http.Header, body []byte) ([]DeliveryEvent, error) {
+	if len(r.secret) == 0 {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	signature, err := hex.DecodeString(header.Get(FixtureSignatureHeader))
+	if err != nil {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	expected, _ := hex.DecodeString(SignFixture(string(r.secret), body))
+	if !hmac.Equal(signature, expected) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	events := []DeliveryEvent{}
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, ErrInvalidWebhookPayload
+	}
+
+	tracked := events[:0]
+	for _, event := range events {
+		switch event.Type {
+		case DeliveryEventBounced, DeliveryEventComplained, DeliveryEventDelivered, DeliveryEventOpened:
+			tracked = append(tracked, event)
+		}
+	}
+
+	return tracked, nil
+}
@@ -0,0 +1,77 @@
+//
+// Mailer
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"fmt"
+
+	"github.com/OutClimb/OutClimb/internal/utils"
+)
+
+const (
+	TransportFile   = "file"
+	TransportMemory = "memory"
+	TransportResend = "resend"
+	TransportSMTP   = "smtp"
+)
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+type Message struct {
+	From        string
+	To          []string
+	Subject     string
+	HtmlBody    string
+	TextBody    string
+	Attachments []Attachment
+	// Headers are added to the message as is, e.g. List-Unsubscribe.
+	Headers map[string]string
+}
+
+// Mailer hands a rendered message to a transport. The returned id identifies
+// the message with the transport, e.g. the provider's id or the Message-ID
+// header.
+type Mailer interface {
+	Send(msg *Message) (string, error)
+}
+
+// New returns the configured transport, or nil when no transport is set and
+// there is no Resend key, meaning email is turned off.
+func New(config *utils.MailerConfig) (Mailer, error) {
+	switch config.Transport {
+	case "":
+		if len(config.ResendApiKey) == 0 {
+			return nil, nil
+		}
+		return NewResendMailer(config.ResendApiKey), nil
+	case TransportResend:
+		return NewResendMailer(config.ResendApiKey), nil
+	case TransportSMTP:
+		return NewSMTPMailer(config.SmtpHost, config.SmtpPort, config.SmtpUsername, config.SmtpPassword), nil
+	case TransportFile:
+		return NewFileMailer(config.FileDirectory)
+	case TransportMemory:
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("unknown email transport %q", config.Transport)
+	}
+}
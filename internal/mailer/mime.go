@@ -0,0 +1,168 @@
+//
+// MIME Encoding
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+)
+
+func newMessageId(from string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = strings.Trim(from[at+1:], "> ")
+	}
+
+	return "<" + hex.EncodeToString(buf) + "@" + domain + ">", nil
+}
+
+// buildMIME renders msg as an RFC 5322 message with text and HTML
+// alternatives, for transports that deal in raw messages. Attachments wrap the
+// alternatives in a multipart/mixed message.
+func buildMIME(msg *Message, messageId string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("From: " + msg.From + "\r\n")
+	buf.WriteString("To: " + strings.Join(msg.To, ", ") + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject) + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("Message-ID: " + messageId + "\r\n")
+	for _, name := range slices.Sorted(maps.Keys(msg.Headers)) {
+		buf.WriteString(textproto.CanonicalMIMEHeaderKey(name) + ": " + msg.Headers[name] + "\r\n")
+	}
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(msg.Attachments) == 0 {
+		writer := multipart.NewWriter(&buf)
+		buf.WriteString("Content-Type: multipart/alternative; boundary=" + writer.Boundary() + "\r\n")
+		buf.WriteString("\r\n")
+
+		if err := writeAlternatives(writer, msg); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	buf.WriteString("Content-Type: multipart/mixed; boundary=" + mixed.Boundary() + "\r\n")
+	buf.WriteString("\r\n")
+
+	var alternativesBuf bytes.Buffer
+	alternatives := multipart.NewWriter(&alternativesBuf)
+	if err := writeAlternatives(alternatives, msg); err != nil {
+		return nil, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", "multipart/alternative; boundary="+alternatives.Boundary())
+	alternativesPart, err := mixed.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := alternativesPart.Write(alternativesBuf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range msg.Attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", attachment.ContentType)
+		header.Set("Content-Transfer-Encoding", "base64")
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+
+		attachmentPart, err := mixed.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := attachmentPart.Write(wrapBase64(attachment.Content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// wrapBase64 encodes content in lines of 76 characters as RFC 2045 requires.
+func wrapBase64(content []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(content)
+
+	var buf bytes.Buffer
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+
+	return buf.Bytes()
+}
+
+// writeAlternatives writes the text and HTML bodies as the parts of writer and
+// closes it.
+func writeAlternatives(writer *multipart.Writer, msg *Message) error {
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", msg.TextBody},
+		{"text/html; charset=utf-8", msg.HtmlBody},
+	}
+
+	for _, part := range parts {
+		if len(part.body) == 0 {
+			continue
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType)
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+
+		qp := quotedprintable.NewWriter(partWriter)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return err
+		}
+		if err := qp.Close(); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
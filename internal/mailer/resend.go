@@ -0,0 +1,56 @@
+//
+// Resend Mailer
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import "github.com/resend/resend-go/v3"
+
+type resendMailer struct {
+	client *resend.Client
+}
+
+func NewResendMailer(apiKey string) *resendMailer {
+	return &resendMailer{
+		client: resend.NewClient(apiKey),
+	}
+}
+
+func (m *resendMailer) Send(msg *Message) (string, error) {
+	params := &resend.SendEmailRequest{
+		From:    msg.From,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Html:    msg.HtmlBody,
+		Text:    msg.TextBody,
+		Headers: msg.Headers,
+	}
+
+	for _, attachment := range msg.Attachments {
+		params.Attachments = append(params.Attachments, &resend.Attachment{
+			Content:     attachment.Content,
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+		})
+	}
+
+	sent, err := m.client.Emails.Send(params)
+	if err != nil {
+		return "", err
+	}
+
+	return sent.Id, nil
+}
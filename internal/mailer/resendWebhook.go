@@ -0,0 +1,144 @@
+//
+// Resend Webhooks
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// resendWebhookTolerance is how far a webhook's timestamp may be from now
+// before it is rejected as a replay.
+const resendWebhookTolerance = 5 * time.Minute
+
+var resendEventTypes = map[string]string{
+	"email.bounced":    DeliveryEventBounced,
+	"email.complained": DeliveryEventComplained,
+	"email.delivered":  DeliveryEventDelivered,
+	"email.opened":     DeliveryEventOpened,
+}
+
+type resendWebhookPayload struct {
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      struct {
+		EmailId string   `json:"email_id"`
+		To      []string `json:"to"`
+		Bounce  *struct {
+			Type    string `json:"type"`
+			SubType string `json:"subType"`
+			Message string `json:"message"`
+		} `json:"bounce"`
+	} `json:"data"`
+}
+
+// resendWebhookReceiver verifies Resend webhooks, which are signed the way
+// Svix signs them: an HMAC over the message id, timestamp and body, keyed
+// with the base64 part of a "whsec_" secret.
+type resendWebhookReceiver struct {
+	secret []byte
+}
+
+func NewResendWebhookReceiver(secret string) *resendWebhookReceiver {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+	if err != nil {
+		key = nil
+	}
+
+	return &resendWebhookReceiver{
+		secret: key,
+	}
+}
+
+func (r *resendWebhookReceiver) verify(header http.Header, body []byte) error {
+	if len(r.secret) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+
+	id := header.Get("svix-id")
+	timestamp := header.Get("svix-timestamp")
+	signatures := header.Get("svix-signature")
+	if len(id) == 0 || len(timestamp) == 0 || len(signatures) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+	if age := time.Since(time.Unix(seconds, 0)); age > resendWebhookTolerance || age < -resendWebhookTolerance {
+		return ErrInvalidWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, r.secret)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	// The header may carry several space-separated signatures while the
+	// secret is being rotated.
+	for _, signature := range strings.Fields(signatures) {
+		version, value, ok := strings.Cut(signature, ",")
+		if !ok || version != "v1" {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidWebhookSignature
+}
+
+func (r *resendWebhookReceiver) Parse(header http.Header, body []byte) ([]DeliveryEvent, error) {
+	if err := r.verify(header, body); err != nil {
+		return nil, err
+	}
+
+	payload := resendWebhookPayload{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, ErrInvalidWebhookPayload
+	}
+
+	eventType, ok := resendEventTypes[payload.Type]
+	if !ok {
+		return []DeliveryEvent{}, nil
+	}
+
+	event := DeliveryEvent{
+		Type:              eventType,
+		ProviderMessageID: payload.Data.EmailId,
+		Recipients:        payload.Data.To,
+		OccurredAt:        payload.CreatedAt,
+	}
+
+	if payload.Data.Bounce != nil {
+		event.HardBounce = payload.Data.Bounce.Type == "Permanent"
+		event.Detail = strings.TrimSpace(payload.Data.Bounce.Type + " " + payload.Data.Bounce.SubType + ": " + payload.Data.Bounce.Message)
+	}
+
+	return []DeliveryEvent{event}, nil
+}
@@ -0,0 +1,73 @@
+//
+// SMTP Mailer
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"net"
+	"net/mail"
+	"net/smtp"
+)
+
+type smtpMailer struct {
+	address  string
+	host     string
+	username string
+	password string
+}
+
+func NewSMTPMailer(host, port, username, password string) *smtpMailer {
+	if len(port) == 0 {
+		port = "25"
+	}
+
+	return &smtpMailer{
+		address:  net.JoinHostPort(host, port),
+		host:     host,
+		username: username,
+		password: password,
+	}
+}
+
+func (m *smtpMailer) Send(msg *Message) (string, error) {
+	messageId, err := newMessageId(msg.From)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := buildMIME(msg, messageId)
+	if err != nil {
+		return "", err
+	}
+
+	// The envelope sender must be a bare address even when From has a name.
+	from := msg.From
+	if address, err := mail.ParseAddress(msg.From); err == nil {
+		from = address.Address
+	}
+
+	var auth smtp.Auth
+	if len(m.username) > 0 {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	if err := smtp.SendMail(m.address, auth, from, msg.To, body); err != nil {
+		return "", err
+	}
+
+	return messageId, nil
+}
@@ -0,0 +1,67 @@
+//
+// Email Webhooks
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/utils"
+)
+
+const (
+	DeliveryEventBounced    = "bounced"
+	DeliveryEventComplained = "complained"
+	DeliveryEventDelivered  = "delivered"
+	DeliveryEventOpened     = "opened"
+)
+
+var (
+	ErrInvalidWebhookPayload   = errors.New("invalid webhook payload")
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+)
+
+// DeliveryEvent is something the provider reports happened to a message after
+// it was handed over. ProviderMessageID matches the id Send returned.
+type DeliveryEvent struct {
+	Type              string    `json:"type"`
+	ProviderMessageID string    `json:"providerMessageId"`
+	Recipients        []string  `json:"recipients"`
+	HardBounce        bool      `json:"hardBounce"`
+	Detail            string    `json:"detail"`
+	OccurredAt        time.Time `json:"occurredAt"`
+}
+
+// WebhookReceiver verifies a webhook request from the provider and parses the
+// delivery events in it. Events of types the app does not track are dropped.
+type WebhookReceiver interface {
+	Parse(header http.Header, body []byte) ([]DeliveryEvent, error)
+}
+
+// NewWebhookReceiver returns the receiver matching the configured transport.
+// Transports without a provider accept signed fixture events instead, so
+// delivery events can be replayed locally.
+func NewWebhookReceiver(config *utils.MailerConfig) WebhookReceiver {
+	switch config.Transport {
+	case "", TransportResend:
+		return NewResendWebhookReceiver(config.WebhookSecret)
+	default:
+		return NewFixtureWebhookReceiver(config.WebhookSecret)
+	}
+}
@@ -0,0 +1,132 @@
+//
+// Webhook Tests
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mailer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testResendSecret = "whsec_dGVzdC1zZWNyZXQtZm9yLXdlYmhvb2tz"
+
+func signResend(t *testing.T, secret, id string, at time.Time, body []byte) http.Header {
+	t.Helper()
+
+	key, err := base64.StdEncoding.DecodeString(secret[len("whsec_"):])
+	if err != nil {
+		t.Fatalf("decode secret: %v", err)
+	}
+
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+
+	header := http.Header{}
+	header.Set("svix-id", id)
+	header.Set("svix-timestamp", timestamp)
+	header.Set("svix-signature", "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return header
+}
+
+func TestResendWebhookParsesSignedEvent(t *testing.T) {
+	body := []byte(`{"type":"email.bounced","created_at":"2026-03-01T12:00:00Z","data":{"email_id":"re_123","to":["alex@example.com"],"bounce":{"type":"Permanent","subType":"General","message":"No such user"}}}`)
+	header := signResend(t, testResendSecret, "msg_1", time.Now(), body)
+
+	events, err := NewResendWebhookReceiver(testResendSecret).Parse(header, body)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("events: got %d, want 1", len(events))
+	}
+
+	event := events[0]
+	if event.Type != DeliveryEventBounced || event.ProviderMessageID != "re_123" || !event.HardBounce {
+		t.Fatalf("unexpected event: %+v", event)
+	}
+}
+
+func TestResendWebhookAcceptsAnyRotatedSignature(t *testing.T) {
+	body := []byte(`{"type":"email.delivered","data":{"email_id":"re_123"}}`)
+	header := signResend(t, testResendSecret, "msg_1", time.Now(), body)
+	header.Set("svix-signature", "v1,b2xkLXNpZ25hdHVyZQ== "+header.Get("svix-signature"))
+
+	if _, err := NewResendWebhookReceiver(testResendSecret).Parse(header, body); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+}
+
+func TestResendWebhookRejectsBadSignatures(t *testing.T) {
+	body := []byte(`{"type":"email.delivered","data":{"email_id":"re_123"}}`)
+	receiver := NewResendWebhookReceiver(testResendSecret)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   []byte
+	}{
+		{"tampered body", signResend(t, testResendSecret, "msg_1", time.Now(), body), []byte(`{"type":"email.delivered","data":{"email_id":"re_999"}}`)},
+		{"wrong secret", signResend(t, "whsec_b3RoZXItc2VjcmV0", "msg_1", time.Now(), body), body},
+		{"expired timestamp", signResend(t, testResendSecret, "msg_1", time.Now().Add(-time.Hour), body), body},
+		{"missing headers", http.Header{}, body},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := receiver.Parse(test.header, test.body); !errors.Is(err, ErrInvalidWebhookSignature) {
+				t.Fatalf("got %v, want ErrInvalidWebhookSignature", err)
+			}
+		})
+	}
+}
+
+func TestResendWebhookWithoutSecretRejectsEverything(t *testing.T) {
+	body := []byte(`{"type":"email.delivered","data":{"email_id":"re_123"}}`)
+	header := signResend(t, testResendSecret, "msg_1", time.Now(), body)
+
+	if _, err := NewResendWebhookReceiver("").Parse(header, body); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("got %v, want ErrInvalidWebhookSignature", err)
+	}
+}
+
+func TestFixtureWebhookVerifiesSignature(t *testing.T) {
+	body := []byte(`[{"type":"delivered","providerMessageId":"memory-1"},{"type":"sent","providerMessageId":"memory-1"}]`)
+	receiver := NewFixtureWebhookReceiver("secret")
+
+	header := http.Header{}
+	header.Set(FixtureSignatureHeader, SignFixture("secret", body))
+	events, err := receiver.Parse(header, body)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != DeliveryEventDelivered {
+		t.Fatalf("untracked event types should be dropped: %+v", events)
+	}
+
+	header.Set(FixtureSignatureHeader, SignFixture("other", body))
+	if _, err := receiver.Parse(header, body); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("got %v, want ErrInvalidWebhookSignature", err)
+	}
+}
@@ -141,6 +141,19 @@ func (s *storeLayer) GetAsset(id uint) (*Asset, error) {
 	return &asset, nil
 }
 
+func (s *storeLayer) GetAssets(ids []uint) (*[]Asset, error) {
+	assets := []Asset{}
+	if len(ids) == 0 {
+		return &assets, nil
+	}
+
+	if result := s.db.Where("id IN ?", ids).Find(&assets); result.Error != nil {
+		return &[]Asset{}, result.Error
+	}
+
+	return &assets, nil
+}
+
 func (s *storeLayer) UpdateAsset(id uint, updatedBy, filename, contentType, data string) (*Asset, error) {
 	asset, err := s.GetAsset(id)
 	if err != nil {
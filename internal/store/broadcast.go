@@ -0,0 +1,64 @@
+//
+// Broadcast DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+type Broadcast struct {
+	StandardAudit
+	EmailSlug      string `gorm:"not null;size:255"`
+	FormID         uint   `gorm:"index;not null"`
+	Filters        *string
+	RecipientCount uint `gorm:"not null;default:0"`
+}
+
+func (s *storeLayer) CreateBroadcast(createdBy, emailSlug string, formId uint, filters *string, recipientCount uint) (*Broadcast, error) {
+	broadcast := Broadcast{
+		EmailSlug:      emailSlug,
+		FormID:         formId,
+		Filters:        filters,
+		RecipientCount: recipientCount,
+	}
+
+	broadcast.CreatedBy = createdBy
+	broadcast.UpdatedBy = createdBy
+
+	if result := s.db.Create(&broadcast); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &broadcast, nil
+}
+
+func (s *storeLayer) GetAllBroadcasts() (*[]Broadcast, error) {
+	broadcasts := []Broadcast{}
+
+	if result := s.db.Order("created_at DESC").Find(&broadcasts); result.Error != nil {
+		return &[]Broadcast{}, result.Error
+	}
+
+	return &broadcasts, nil
+}
+
+func (s *storeLayer) GetBroadcast(id uint) (*Broadcast, error) {
+	broadcast := Broadcast{}
+
+	if result := s.db.First(&broadcast, id); result.Error != nil {
+		return &Broadcast{}, result.Error
+	}
+
+	return &broadcast, nil
+}
@@ -0,0 +1,97 @@
+//
+// Digest Preference DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// DigestPreference is when a user wants their weekly digest. Weekday counts
+// from Sunday as zero and Hour is in the configured timezone.
+type DigestPreference struct {
+	ID         uint `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	UserID     uint `gorm:"uniqueIndex;not null"`
+	Enabled    bool `gorm:"not null;default:false"`
+	Weekday    uint `gorm:"not null;default:1"`
+	Hour       uint `gorm:"not null;default:8"`
+	LastSentAt *time.Time
+}
+
+// ClaimDigest marks the digest scheduled for scheduledAt as sent and reports
+// whether this call was the one to do so.
+func (s *storeLayer) ClaimDigest(id uint, scheduledAt time.Time) (bool, error) {
+	result := s.db.Model(&DigestPreference{}).
+		Where("id = ? AND (last_sent_at IS NULL OR last_sent_at < ?)", id, scheduledAt).
+		Update("last_sent_at", scheduledAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (s *storeLayer) GetDigestPreferenceForUser(userId uint) (*DigestPreference, error) {
+	preference := DigestPreference{}
+
+	if result := s.db.Where("user_id = ?", userId).First(&preference); result.Error != nil {
+		return &DigestPreference{}, result.Error
+	}
+
+	return &preference, nil
+}
+
+func (s *storeLayer) GetEnabledDigestPreferences() (*[]DigestPreference, error) {
+	preferences := []DigestPreference{}
+
+	if result := s.db.Where("enabled = ?", true).Find(&preferences); result.Error != nil {
+		return &[]DigestPreference{}, result.Error
+	}
+
+	return &preferences, nil
+}
+
+// SetDigestPreference creates or updates the user's preference. lastSentAt
+// is only written when it is not nil.
+func (s *storeLayer) SetDigestPreference(userId uint, enabled bool, weekday, hour uint, lastSentAt *time.Time) (*DigestPreference, error) {
+	preference := DigestPreference{
+		UserID:     userId,
+		Enabled:    enabled,
+		Weekday:    weekday,
+		Hour:       hour,
+		LastSentAt: lastSentAt,
+	}
+
+	columns := []string{"updated_at", "enabled", "weekday", "hour"}
+	if lastSentAt != nil {
+		columns = append(columns, "last_sent_at")
+	}
+
+	result := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).Create(&preference)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return s.GetDigestPreferenceForUser(userId)
+}
@@ -19,20 +19,26 @@ package store
 
 type Email struct {
 	StandardAudit
-	Name     string `gorm:"not null"`
-	Slug     string `gorm:"uniqueIndex;not null;size:255"`
-	Subject  string `gorm:"not null"`
-	HtmlBody string `gorm:"not null"`
-	TextBody string `gorm:"not null"`
+	Name         string `gorm:"not null"`
+	Slug         string `gorm:"uniqueIndex;not null;size:255"`
+	LayoutSlug   *string
+	Subject      string `gorm:"not null"`
+	MarkdownBody *string
+	HtmlBody     string `gorm:"not null"`
+	TextBody     string `gorm:"not null"`
+	Revision     uint   `gorm:"not null;default:0"`
 }
 
-func (s *storeLayer) CreateEmail(createdBy, name, slug, subject, htmlBody, textBody string) (*Email, error) {
+func (s *storeLayer) CreateEmail(createdBy, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*Email, error) {
 	email := Email{
-		Name:     name,
-		Slug:     slug,
-		Subject:  subject,
-		HtmlBody: htmlBody,
-		TextBody: textBody,
+		Name:         name,
+		Slug:         slug,
+		LayoutSlug:   layoutSlug,
+		Subject:      subject,
+		MarkdownBody: markdownBody,
+		HtmlBody:     htmlBody,
+		TextBody:     textBody,
+		Revision:     1,
 	}
 
 	email.CreatedBy = createdBy
@@ -83,7 +89,7 @@ func (s *storeLayer) GetEmailWithSlug(slug string) (*Email, error) {
 	return &email, nil
 }
 
-func (s *storeLayer) UpdateEmail(id uint, updatedBy, name, slug, subject, htmlBody, textBody string) (*Email, error) {
+func (s *storeLayer) UpdateEmail(id uint, updatedBy, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*Email, error) {
 	email, err := s.GetEmail(id)
 	if err != nil {
 		return nil, err
@@ -92,9 +98,12 @@ func (s *storeLayer) UpdateEmail(id uint, updatedBy, name, slug, subject, htmlBo
 	email.UpdatedBy = updatedBy
 	email.Name = name
 	email.Slug = slug
+	email.LayoutSlug = layoutSlug
 	email.Subject = subject
+	email.MarkdownBody = markdownBody
 	email.HtmlBody = htmlBody
 	email.TextBody = textBody
+	email.Revision++
 
 	if result := s.db.Save(&email); result.Error != nil {
 		return nil, result.Error
@@ -0,0 +1,106 @@
+//
+// Email Partial DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+// EmailPartial is a named snippet shared between email templates. A layout is
+// a partial that wraps a whole email by calling {{template "content" .}}.
+type EmailPartial struct {
+	StandardAudit
+	Name     string `gorm:"not null"`
+	Slug     string `gorm:"uniqueIndex;not null;size:255"`
+	Layout   bool   `gorm:"not null;default:false"`
+	HtmlBody string `gorm:"not null"`
+	TextBody string `gorm:"not null"`
+}
+
+func (s *storeLayer) CreateEmailPartial(createdBy, name, slug string, layout bool, htmlBody, textBody string) (*EmailPartial, error) {
+	partial := EmailPartial{
+		Name:     name,
+		Slug:     slug,
+		Layout:   layout,
+		HtmlBody: htmlBody,
+		TextBody: textBody,
+	}
+
+	partial.CreatedBy = createdBy
+	partial.UpdatedBy = createdBy
+
+	if result := s.db.Create(&partial); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &partial, nil
+}
+
+func (s *storeLayer) DeleteEmailPartial(id uint) error {
+	if result := s.db.Delete(&EmailPartial{}, id); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) GetAllEmailPartials() (*[]EmailPartial, error) {
+	partials := []EmailPartial{}
+
+	if result := s.db.Order("slug").Find(&partials); result.Error != nil {
+		return &[]EmailPartial{}, result.Error
+	}
+
+	return &partials, nil
+}
+
+func (s *storeLayer) GetEmailPartial(id uint) (*EmailPartial, error) {
+	partial := EmailPartial{}
+
+	if result := s.db.First(&partial, id); result.Error != nil {
+		return &EmailPartial{}, result.Error
+	}
+
+	return &partial, nil
+}
+
+func (s *storeLayer) GetEmailPartialWithSlug(slug string) (*EmailPartial, error) {
+	partial := EmailPartial{}
+
+	if result := s.db.Where("slug = ?", slug).First(&partial); result.Error != nil {
+		return &EmailPartial{}, result.Error
+	}
+
+	return &partial, nil
+}
+
+func (s *storeLayer) UpdateEmailPartial(id uint, updatedBy, name, slug string, layout bool, htmlBody, textBody string) (*EmailPartial, error) {
+	partial, err := s.GetEmailPartial(id)
+	if err != nil {
+		return nil, err
+	}
+
+	partial.UpdatedBy = updatedBy
+	partial.Name = name
+	partial.Slug = slug
+	partial.Layout = layout
+	partial.HtmlBody = htmlBody
+	partial.TextBody = textBody
+
+	if result := s.db.Save(&partial); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return partial, nil
+}
@@ -0,0 +1,81 @@
+//
+// Email Revision DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import "time"
+
+// EmailRevision is a copy of an email as it was saved. Revisions are numbered
+// from one per email and are never changed once written.
+type EmailRevision struct {
+	ID           uint `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	CreatedBy    string `gorm:"not null"`
+	EmailID      uint   `gorm:"uniqueIndex:idx_email_revisions_email_revision;not null"`
+	Revision     uint   `gorm:"uniqueIndex:idx_email_revisions_email_revision;not null"`
+	Name         string `gorm:"not null"`
+	Slug         string `gorm:"not null;size:255"`
+	LayoutSlug   *string
+	Subject      string `gorm:"not null"`
+	MarkdownBody *string
+	HtmlBody     string `gorm:"not null"`
+	TextBody     string `gorm:"not null"`
+}
+
+// CreateEmailRevision records the email as it is now under its current
+// revision number.
+func (s *storeLayer) CreateEmailRevision(email *Email) (*EmailRevision, error) {
+	revision := EmailRevision{
+		CreatedBy:    email.UpdatedBy,
+		EmailID:      email.ID,
+		Revision:     email.Revision,
+		Name:         email.Name,
+		Slug:         email.Slug,
+		LayoutSlug:   email.LayoutSlug,
+		Subject:      email.Subject,
+		MarkdownBody: email.MarkdownBody,
+		HtmlBody:     email.HtmlBody,
+		TextBody:     email.TextBody,
+	}
+
+	if result := s.db.Create(&revision); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &revision, nil
+}
+
+func (s *storeLayer) GetEmailRevision(emailId, revision uint) (*EmailRevision, error) {
+	emailRevision := EmailRevision{}
+
+	if result := s.db.Where("email_id = ? AND revision = ?", emailId, revision).First(&emailRevision); result.Error != nil {
+		return &EmailRevision{}, result.Error
+	}
+
+	return &emailRevision, nil
+}
+
+// GetEmailRevisions lists an email's revisions, newest first.
+func (s *storeLayer) GetEmailRevisions(emailId uint) (*[]EmailRevision, error) {
+	revisions := []EmailRevision{}
+
+	if result := s.db.Where("email_id = ?", emailId).Order("revision DESC").Find(&revisions); result.Error != nil {
+		return &[]EmailRevision{}, result.Error
+	}
+
+	return &revisions, nil
+}
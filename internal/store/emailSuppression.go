@@ -0,0 +1,108 @@
+//
+// Email Suppression DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SuppressionReasonBounce      = "bounce"
+	SuppressionReasonComplaint   = "complaint"
+	SuppressionReasonManual      = "manual"
+	SuppressionReasonUnsubscribe = "unsubscribe"
+)
+
+// EmailSuppression is an address bulk email must not be sent to. Addresses are
+// stored lowercased.
+type EmailSuppression struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	Address   string `gorm:"uniqueIndex;not null;size:320"`
+	Reason    string `gorm:"not null;size:32"`
+}
+
+// CreateEmailSuppression adds the address to the list. An address that is
+// already suppressed keeps its original reason and timestamp.
+func (s *storeLayer) CreateEmailSuppression(address, reason string) (*EmailSuppression, error) {
+	suppression := EmailSuppression{
+		Address: address,
+		Reason:  reason,
+	}
+
+	if result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&suppression); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return s.GetEmailSuppressionWithAddress(address)
+}
+
+func (s *storeLayer) DeleteEmailSuppression(id uint) error {
+	if result := s.db.Delete(&EmailSuppression{}, id); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) GetAllEmailSuppressions() (*[]EmailSuppression, error) {
+	suppressions := []EmailSuppression{}
+
+	if result := s.db.Order("created_at DESC").Find(&suppressions); result.Error != nil {
+		return &[]EmailSuppression{}, result.Error
+	}
+
+	return &suppressions, nil
+}
+
+func (s *storeLayer) GetEmailSuppression(id uint) (*EmailSuppression, error) {
+	suppression := EmailSuppression{}
+
+	if result := s.db.First(&suppression, id); result.Error != nil {
+		return &EmailSuppression{}, result.Error
+	}
+
+	return &suppression, nil
+}
+
+func (s *storeLayer) GetEmailSuppressionWithAddress(address string) (*EmailSuppression, error) {
+	suppression := EmailSuppression{}
+
+	if result := s.db.Where("address = ?", address).First(&suppression); result.Error != nil {
+		return &EmailSuppression{}, result.Error
+	}
+
+	return &suppression, nil
+}
+
+// GetEmailSuppressionsForAddresses returns the suppressions matching any of
+// the given lowercased addresses.
+func (s *storeLayer) GetEmailSuppressionsForAddresses(addresses []string) (*[]EmailSuppression, error) {
+	suppressions := []EmailSuppression{}
+	if len(addresses) == 0 {
+		return &suppressions, nil
+	}
+
+	if result := s.db.Where("address IN ?", addresses).Find(&suppressions); result.Error != nil {
+		return &[]EmailSuppression{}, result.Error
+	}
+
+	return &suppressions, nil
+}
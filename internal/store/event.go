@@ -1,5 +1,5 @@
 //
-// Event Store
+// Event DB Object
 // Copyright 2026 OutClimb
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -17,112 +17,117 @@
 
 package store
 
-import (
-	"encoding/xml"
-	"fmt"
-	"io"
-	"net/http"
-	"time"
-)
-
-var eventsHTTPClient = &http.Client{Timeout: 10 * time.Second}
+import "time"
 
 type Event struct {
-	Title          string
-	Link           string
-	Description    string
-	ContentEncoded string
-	MediaURL       string
-	MediaType      string
-	PubDate        string
-	EventDate      time.Time
-	GUID           string
+	StandardAudit
+	Title        string    `gorm:"not null"`
+	StartsAt     time.Time `gorm:"not null"`
+	EndsAt       *time.Time
+	LocationID   *uint
+	ImageAssetID *uint
+	Description  string
+	FormID       *uint
+	Link         string
+	Published    bool `gorm:"not null;default:false"`
+	Categories   string
 }
 
-type EventFeed struct {
-	Title       string
-	Link        string
-	Description string
-	Events      []*Event
-}
+func (s *storeLayer) CreateEvent(createdBy, title string, startsAt time.Time, endsAt *time.Time, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories string) (*Event, error) {
+	event := Event{
+		Title:        title,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		LocationID:   locationId,
+		ImageAssetID: imageAssetId,
+		Description:  description,
+		FormID:       formId,
+		Link:         link,
+		Published:    published,
+		Categories:   categories,
+	}
 
-type rssRoot struct {
-	XMLName xml.Name   `xml:"rss"`
-	Channel rssChannel `xml:"channel"`
-}
+	event.CreatedBy = createdBy
+	event.UpdatedBy = createdBy
 
-type rssChannel struct {
-	Title       string    `xml:"title"`
-	Link        string    `xml:"link"`
-	Description string    `xml:"description"`
-	Items       []rssItem `xml:"item"`
-}
+	if result := s.db.Create(&event); result.Error != nil {
+		return nil, result.Error
+	}
 
-type rssMediaContent struct {
-	URL  string `xml:"url,attr"`
-	Type string `xml:"type,attr"`
+	return &event, nil
 }
 
-type rssItem struct {
-	Title          string          `xml:"title"`
-	Link           string          `xml:"link"`
-	Description    string          `xml:"description"`
-	PubDate        string          `xml:"pubDate"`
-	GUID           string          `xml:"guid"`
-	ContentEncoded string          `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
-	MediaContent   rssMediaContent `xml:"http://www.rssboard.org/media-rss content"`
+func (s *storeLayer) DeleteEvent(id uint) error {
+	if result := s.db.Delete(&Event{}, id); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
 }
 
-// parseEventDateFromURL extracts the event date from the trailing YYYY-MM-DD in the event URL.
-func parseEventDateFromURL(link string) (time.Time, error) {
-	if len(link) < 10 {
-		return time.Time{}, fmt.Errorf("link too short to contain a date: %s", link)
+func (s *storeLayer) GetAllEvents() (*[]Event, error) {
+	events := []Event{}
+
+	if result := s.db.Order("starts_at").Find(&events); result.Error != nil {
+		return &[]Event{}, result.Error
 	}
-	return time.Parse("2006-01-02", link[len(link)-10:])
+
+	return &events, nil
 }
 
-func (s *storeLayer) GetAllEvents() (*EventFeed, error) {
-	resp, err := eventsHTTPClient.Get(s.storeConfig.EventsRSSURL)
-	if err != nil {
-		return nil, err
+func (s *storeLayer) GetEvent(id uint) (*Event, error) {
+	event := Event{}
+
+	if result := s.db.First(&event, id); result.Error != nil {
+		return &Event{}, result.Error
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	return &event, nil
+}
+
+// GetEventForForm returns the event a form registers people for, if one has
+// been linked to it.
+func (s *storeLayer) GetEventForForm(formId uint) (*Event, error) {
+	event := Event{}
+
+	if result := s.db.Where("form_id = ?", formId).First(&event); result.Error != nil {
+		return &Event{}, result.Error
 	}
 
-	var raw rssRoot
-	if err := xml.Unmarshal(body, &raw); err != nil {
-		return nil, err
+	return &event, nil
+}
+
+func (s *storeLayer) GetPublishedEvents() (*[]Event, error) {
+	events := []Event{}
+
+	if result := s.db.Where("published = ?", true).Order("starts_at").Find(&events); result.Error != nil {
+		return &[]Event{}, result.Error
 	}
 
-	feed := &EventFeed{
-		Title:       raw.Channel.Title,
-		Link:        raw.Channel.Link,
-		Description: raw.Channel.Description,
+	return &events, nil
+}
+
+func (s *storeLayer) UpdateEvent(id uint, updatedBy, title string, startsAt time.Time, endsAt *time.Time, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories string) (*Event, error) {
+	event, err := s.GetEvent(id)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, item := range raw.Channel.Items {
-		eventDate, err := parseEventDateFromURL(item.Link)
-		if err != nil {
-			continue
-		}
-		feed.Events = append(feed.Events, &Event{
-			Title:          item.Title,
-			Link:           item.Link,
-			Description:    item.Description,
-			ContentEncoded: item.ContentEncoded,
-			MediaURL:       item.MediaContent.URL,
-			MediaType:      item.MediaContent.Type,
-			PubDate:        item.PubDate,
-			EventDate:      eventDate,
-			GUID:           item.GUID,
-		})
+	event.UpdatedBy = updatedBy
+	event.Title = title
+	event.StartsAt = startsAt
+	event.EndsAt = endsAt
+	event.LocationID = locationId
+	event.ImageAssetID = imageAssetId
+	event.Description = description
+	event.FormID = formId
+	event.Link = link
+	event.Published = published
+	event.Categories = categories
+
+	if result := s.db.Save(&event); result.Error != nil {
+		return nil, result.Error
 	}
 
-	return feed, nil
+	return event, nil
 }
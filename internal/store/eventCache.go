@@ -0,0 +1,191 @@
+//
+// Event Cache
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultEventsCacheTTL = 5 * time.Minute
+
+var errEventsNotModified = errors.New("events feed not modified")
+
+// eventCache keeps the last good copy of the events feed. A copy older than
+// the TTL is still served while a background refresh fetches a new one, and
+// is kept when the upstream feed cannot be fetched.
+type eventCache struct {
+	client *http.Client
+	url    string
+	ttl    time.Duration
+	dates  *eventDateParser
+
+	// fetching is held for the length of a fetch so concurrent misses wait
+	// for one request instead of each making their own.
+	fetching sync.Mutex
+
+	mu         sync.Mutex
+	feed       *EventFeed
+	etag       string
+	refreshing bool
+	// retryAt holds off background refreshes after one fails, so a slow or
+	// down upstream is not hit on every request.
+	retryAt time.Time
+}
+
+func newEventCache(url string, ttl time.Duration, dates *eventDateParser, client *http.Client) *eventCache {
+	if ttl <= 0 {
+		ttl = defaultEventsCacheTTL
+	}
+
+	return &eventCache{
+		client: client,
+		url:    url,
+		ttl:    ttl,
+		dates:  dates,
+	}
+}
+
+func parseEventsCacheTTL(value string) time.Duration {
+	if len(value) == 0 {
+		return defaultEventsCacheTTL
+	}
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl <= 0 {
+		slog.Error("Failed to parse events cache TTL, using default",
+			"layer", "store",
+			"input", value,
+			"default", defaultEventsCacheTTL,
+			"error", err,
+		)
+		return defaultEventsCacheTTL
+	}
+
+	return ttl
+}
+
+func (c *eventCache) get() (*EventFeed, error) {
+	c.mu.Lock()
+	feed := c.feed
+	if feed != nil && time.Since(feed.FetchedAt) >= c.ttl && !c.refreshing && time.Now().After(c.retryAt) {
+		c.refreshing = true
+		go c.backgroundRefresh()
+	}
+	c.mu.Unlock()
+
+	if feed != nil {
+		return feed, nil
+	}
+
+	return c.refresh(false)
+}
+
+func (c *eventCache) backgroundRefresh() {
+	defer func() {
+		c.mu.Lock()
+		c.refreshing = false
+		c.mu.Unlock()
+	}()
+
+	if _, err := c.refresh(false); err != nil {
+		c.mu.Lock()
+		c.retryAt = time.Now().Add(c.ttl)
+		c.mu.Unlock()
+
+		slog.Error("Unable to refresh events feed, serving the last good copy",
+			"layer", "store",
+			"entity", "event",
+			"error", err,
+		)
+	}
+}
+
+// refresh fetches the feed unless another caller fetched a fresh copy while
+// this one waited. force always fetches.
+func (c *eventCache) refresh(force bool) (*EventFeed, error) {
+	c.fetching.Lock()
+	defer c.fetching.Unlock()
+
+	c.mu.Lock()
+	current, etag := c.feed, c.etag
+	c.mu.Unlock()
+
+	if !force && current != nil && time.Since(current.FetchedAt) < c.ttl {
+		return current, nil
+	}
+
+	feed, newEtag, err := c.fetch(etag)
+	if errors.Is(err, errEventsNotModified) && current != nil {
+		refreshed := *current
+		refreshed.FetchedAt = time.Now()
+		feed, newEtag = &refreshed, etag
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.feed, c.etag = feed, newEtag
+	c.mu.Unlock()
+
+	return feed, nil
+}
+
+// fetch downloads and parses the feed, asking the upstream server to skip the
+// body when it still matches etag.
+func (c *eventCache) fetch(etag string) (*EventFeed, string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(etag) > 0 {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, "", errEventsNotModified
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, "", fmt.Errorf("events feed returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	feed, err := c.dates.parseFeed(body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return feed, resp.Header.Get("ETag"), nil
+}
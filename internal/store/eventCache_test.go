@@ -0,0 +1,225 @@
+//
+// Event Cache Tests
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/utils"
+)
+
+// feedServer serves a feed whose title counts the full responses it has
+// sent, so tests can tell which copy the cache is holding.
+type feedServer struct {
+	mu       sync.Mutex
+	requests int
+	served   int
+	status   int
+	etag     string
+	lastTag  string
+}
+
+func (f *feedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests++
+	f.lastTag = r.Header.Get("If-None-Match")
+
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+		return
+	}
+
+	if len(f.etag) > 0 {
+		if f.lastTag == f.etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", f.etag)
+	}
+
+	f.served++
+	w.Header().Set("Content-Type", "application/rss+xml")
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Feed %d</title></channel></rss>`, f.served)
+}
+
+func (f *feedServer) set(status int, etag string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status, f.etag = status, etag
+}
+
+func (f *feedServer) counts() (requests int, lastTag string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests, f.lastTag
+}
+
+func newTestEventCache(t *testing.T, ttl time.Duration) (*eventCache, *feedServer) {
+	t.Helper()
+
+	feed := &feedServer{}
+	server := httptest.NewServer(feed)
+	t.Cleanup(server.Close)
+
+	return newEventCache(server.URL, ttl, newEventDateParser(&utils.StoreConfig{}), server.Client()), feed
+}
+
+func mustGet(t *testing.T, cache *eventCache) *EventFeed {
+	t.Helper()
+
+	feed, err := cache.get()
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	return feed
+}
+
+// waitForTitle polls until a background refresh has swapped in the wanted
+// copy of the feed.
+func waitForTitle(t *testing.T, cache *eventCache, title string) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		cache.mu.Lock()
+		current := cache.feed.Title
+		refreshing := cache.refreshing
+		cache.mu.Unlock()
+
+		if current == title && !refreshing {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("feed never became %q", title)
+}
+
+func TestEventCacheServesFreshCopyWithinTTL(t *testing.T) {
+	cache, server := newTestEventCache(t, time.Hour)
+
+	if title := mustGet(t, cache).Title; title != "Feed 1" {
+		t.Fatalf("first get: got %q, want %q", title, "Feed 1")
+	}
+	if title := mustGet(t, cache).Title; title != "Feed 1" {
+		t.Fatalf("second get: got %q, want %q", title, "Feed 1")
+	}
+
+	if requests, _ := server.counts(); requests != 1 {
+		t.Fatalf("upstream requests: got %d, want 1", requests)
+	}
+}
+
+func TestEventCacheRevalidatesStaleCopyInBackground(t *testing.T) {
+	cache, server := newTestEventCache(t, 20*time.Millisecond)
+
+	mustGet(t, cache)
+	time.Sleep(30 * time.Millisecond)
+
+	// The stale copy is returned straight away while the refresh runs.
+	if title := mustGet(t, cache).Title; title != "Feed 1" {
+		t.Fatalf("stale get: got %q, want %q", title, "Feed 1")
+	}
+
+	waitForTitle(t, cache, "Feed 2")
+	if requests, _ := server.counts(); requests != 2 {
+		t.Fatalf("upstream requests: got %d, want 2", requests)
+	}
+}
+
+func TestEventCacheKeepsStaleCopyWhenUpstreamFails(t *testing.T) {
+	cache, server := newTestEventCache(t, 200*time.Millisecond)
+
+	mustGet(t, cache)
+	server.set(http.StatusBadGateway, "")
+	time.Sleep(250 * time.Millisecond)
+
+	if title := mustGet(t, cache).Title; title != "Feed 1" {
+		t.Fatalf("stale get: got %q, want %q", title, "Feed 1")
+	}
+	waitForTitle(t, cache, "Feed 1")
+
+	// The failure holds off further refreshes, so the stale copy keeps being
+	// served without hitting upstream again.
+	if title := mustGet(t, cache).Title; title != "Feed 1" {
+		t.Fatalf("get after failure: got %q, want %q", title, "Feed 1")
+	}
+	if requests, _ := server.counts(); requests != 2 {
+		t.Fatalf("upstream requests: got %d, want 2", requests)
+	}
+
+	if _, err := cache.refresh(true); err == nil {
+		t.Fatal("forced refresh: expected an error from the failing upstream")
+	}
+	if title := mustGet(t, cache).Title; title != "Feed 1" {
+		t.Fatalf("get after forced failure: got %q, want %q", title, "Feed 1")
+	}
+}
+
+func TestEventCacheUsesETagForNotModified(t *testing.T) {
+	cache, server := newTestEventCache(t, 20*time.Millisecond)
+	server.set(0, `"v1"`)
+
+	first := mustGet(t, cache)
+	time.Sleep(30 * time.Millisecond)
+
+	refreshed, err := cache.refresh(false)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	requests, lastTag := server.counts()
+	if requests != 2 {
+		t.Fatalf("upstream requests: got %d, want 2", requests)
+	}
+	if lastTag != `"v1"` {
+		t.Fatalf("If-None-Match: got %q, want %q", lastTag, `"v1"`)
+	}
+	if refreshed.Title != "Feed 1" {
+		t.Fatalf("title after 304: got %q, want %q", refreshed.Title, "Feed 1")
+	}
+	if !refreshed.FetchedAt.After(first.FetchedAt) {
+		t.Fatal("a 304 should count as a fresh fetch")
+	}
+}
+
+func TestEventCacheForcedRefreshBypassesTTL(t *testing.T) {
+	cache, server := newTestEventCache(t, time.Hour)
+
+	mustGet(t, cache)
+
+	refreshed, err := cache.refresh(true)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if refreshed.Title != "Feed 2" {
+		t.Fatalf("forced refresh: got %q, want %q", refreshed.Title, "Feed 2")
+	}
+	if title := mustGet(t, cache).Title; title != "Feed 2" {
+		t.Fatalf("get after refresh: got %q, want %q", title, "Feed 2")
+	}
+	if requests, _ := server.counts(); requests != 2 {
+		t.Fatalf("upstream requests: got %d, want 2", requests)
+	}
+}
@@ -0,0 +1,330 @@
+//
+// Event Date Parser
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/OutClimb/OutClimb/internal/utils"
+)
+
+const (
+	EventDateSourceElement    = "element"
+	EventDateSourceStructured = "structured"
+	EventDateSourceURL        = "url"
+
+	defaultEventDateSources     = "element,structured,url"
+	defaultEventsURLDatePattern = `(\d{4}-\d{2}-\d{2})/?$`
+)
+
+var (
+	// eventDateLayouts are tried in order on dates that come from the feed
+	// element or structured data. Date only values are tried last.
+	eventDateLayouts = []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		time.RFC1123Z,
+		time.RFC1123,
+	}
+	eventDateOnlyLayout = "2006-01-02"
+
+	eventsJSONLDPattern   = regexp.MustCompile(`(?is)<script[^>]*type=["']application/ld\+json["'][^>]*>(.*?)</script>`)
+	eventsItemPropPattern = regexp.MustCompile(`(?is)<[^>]*\sitemprop=["'](startDate|endDate)["'][^>]*>`)
+	eventsItemValue       = regexp.MustCompile(`(?is)\s(?:content|datetime)=["']([^"']+)["']`)
+)
+
+// eventDateParser works out when an item in the events feed happens, trying
+// each configured source in turn.
+type eventDateParser struct {
+	sources    []string
+	element    xml.Name
+	layout     string
+	urlPattern *regexp.Regexp
+	location   *time.Location
+}
+
+// eventDates is when an item happens. Date is midnight UTC on the day of the
+// event; StartsAt and EndsAt are only set when the source had a time of day.
+type eventDates struct {
+	Date     time.Time
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	Source   string
+}
+
+func newEventDateParser(config *utils.StoreConfig) *eventDateParser {
+	p := &eventDateParser{
+		layout:   strings.TrimSpace(config.EventsDateLayout),
+		location: time.UTC,
+	}
+
+	sources := config.EventsDateSources
+	if len(strings.TrimSpace(sources)) == 0 {
+		sources = defaultEventDateSources
+	}
+	for _, source := range strings.Split(sources, ",") {
+		switch source = strings.ToLower(strings.TrimSpace(source)); source {
+		case EventDateSourceElement, EventDateSourceStructured, EventDateSourceURL:
+			p.sources = append(p.sources, source)
+		default:
+			slog.Error("Ignoring unknown event date source",
+				"layer", "store",
+				"entity", "event",
+				"source", source,
+			)
+		}
+	}
+
+	// The element is written as Go's XML package names it, the namespace URL
+	// then a space and the local name, or just the local name.
+	if element := strings.TrimSpace(config.EventsDateElement); len(element) > 0 {
+		if space, local, found := strings.Cut(element, " "); found {
+			p.element = xml.Name{Space: space, Local: strings.TrimSpace(local)}
+		} else {
+			p.element = xml.Name{Local: element}
+		}
+	}
+
+	pattern := config.EventsURLDatePattern
+	if len(pattern) == 0 {
+		pattern = defaultEventsURLDatePattern
+	}
+	urlPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		slog.Error("Failed to parse events URL date pattern, using default",
+			"layer", "store",
+			"input", pattern,
+			"default", defaultEventsURLDatePattern,
+			"error", err,
+		)
+		urlPattern = regexp.MustCompile(defaultEventsURLDatePattern)
+	}
+	p.urlPattern = urlPattern
+
+	if len(config.EventsTimezone) > 0 {
+		if location, err := time.LoadLocation(config.EventsTimezone); err == nil {
+			p.location = location
+		} else {
+			slog.Error("Failed to load events timezone, using UTC",
+				"layer", "store",
+				"input", config.EventsTimezone,
+				"error", err,
+			)
+		}
+	}
+
+	return p
+}
+
+// parse returns the dates of an item from the first source that has them,
+// or the reason every source failed.
+func (p *eventDateParser) parse(item *rssItem) (*eventDates, error) {
+	reasons := make([]string, 0, len(p.sources))
+	for _, source := range p.sources {
+		var dates *eventDates
+		var err error
+
+		switch source {
+		case EventDateSourceElement:
+			dates, err = p.fromElement(item)
+		case EventDateSourceStructured:
+			dates, err = p.fromStructuredData(item.ContentEncoded)
+		case EventDateSourceURL:
+			dates, err = p.fromURL(item.Link)
+		}
+
+		if err == nil {
+			dates.Source = source
+			return dates, nil
+		}
+		reasons = append(reasons, source+": "+err.Error())
+	}
+
+	if len(reasons) == 0 {
+		return nil, errors.New("no event date sources are configured")
+	}
+
+	return nil, errors.New(strings.Join(reasons, "; "))
+}
+
+func (p *eventDateParser) fromElement(item *rssItem) (*eventDates, error) {
+	if len(p.element.Local) == 0 {
+		return nil, errors.New("no element configured")
+	}
+
+	for _, extra := range item.Extra {
+		if extra.XMLName.Local != p.element.Local {
+			continue
+		}
+		if len(p.element.Space) > 0 && extra.XMLName.Space != p.element.Space {
+			continue
+		}
+
+		return p.fromValues(strings.TrimSpace(extra.Value), "")
+	}
+
+	return nil, fmt.Errorf("item has no %s element", p.element.Local)
+}
+
+// fromStructuredData reads schema.org Event dates, from JSON-LD first and then
+// from microdata attributes.
+func (p *eventDateParser) fromStructuredData(content string) (*eventDates, error) {
+	if len(content) == 0 {
+		return nil, errors.New("item has no content")
+	}
+
+	for _, match := range eventsJSONLDPattern.FindAllStringSubmatch(content, -1) {
+		var data any
+		if err := json.Unmarshal([]byte(match[1]), &data); err != nil {
+			continue
+		}
+		if start, end, ok := findJSONLDEventDates(data); ok {
+			return p.fromValues(start, end)
+		}
+	}
+
+	var start, end string
+	for _, tag := range eventsItemPropPattern.FindAllStringSubmatch(content, -1) {
+		value := eventsItemValue.FindStringSubmatch(tag[0])
+		if value == nil {
+			continue
+		}
+		if strings.EqualFold(tag[1], "startDate") && len(start) == 0 {
+			start = value[1]
+		} else if strings.EqualFold(tag[1], "endDate") && len(end) == 0 {
+			end = value[1]
+		}
+	}
+	if len(start) > 0 {
+		return p.fromValues(start, end)
+	}
+
+	return nil, errors.New("content has no event start date")
+}
+
+// findJSONLDEventDates looks through decoded JSON-LD, including @graph and
+// nested values, for an object with a startDate.
+func findJSONLDEventDates(data any) (string, string, bool) {
+	switch value := data.(type) {
+	case map[string]any:
+		if start, ok := value["startDate"].(string); ok && len(start) > 0 {
+			end, _ := value["endDate"].(string)
+			return start, end, true
+		}
+		for _, child := range value {
+			if start, end, ok := findJSONLDEventDates(child); ok {
+				return start, end, true
+			}
+		}
+	case []any:
+		for _, child := range value {
+			if start, end, ok := findJSONLDEventDates(child); ok {
+				return start, end, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+func (p *eventDateParser) fromURL(link string) (*eventDates, error) {
+	match := p.urlPattern.FindStringSubmatch(link)
+	if match == nil {
+		return nil, errors.New("link does not match the date pattern")
+	}
+
+	value := match[0]
+	if len(match) > 1 {
+		value = match[1]
+	}
+
+	date, err := time.Parse(eventDateOnlyLayout, value)
+	if err != nil {
+		return nil, fmt.Errorf("link date %q is not YYYY-MM-DD", value)
+	}
+
+	return &eventDates{Date: date}, nil
+}
+
+// fromValues parses a start and optional end. An end that cannot be parsed
+// is dropped rather than failing the whole item.
+func (p *eventDateParser) fromValues(start, end string) (*eventDates, error) {
+	startsAt, hasTime, err := p.parseTime(start)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasTime {
+		return &eventDates{Date: startsAt}, nil
+	}
+
+	local := startsAt.In(p.location)
+	dates := &eventDates{
+		Date:     time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC),
+		StartsAt: &startsAt,
+	}
+
+	if len(end) > 0 {
+		if endsAt, endHasTime, err := p.parseTime(end); err == nil && endHasTime && endsAt.After(startsAt) {
+			dates.EndsAt = &endsAt
+		}
+	}
+
+	return dates, nil
+}
+
+// parseTime reads a date, reporting whether it had a time of day. Values
+// without a zone are in the configured timezone; date only values are
+// midnight UTC, like the dates taken from links.
+func (p *eventDateParser) parseTime(value string) (time.Time, bool, error) {
+	value = strings.TrimSpace(value)
+
+	if len(p.layout) > 0 {
+		parsed, err := time.ParseInLocation(p.layout, value, p.location)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("%q does not match the configured layout", value)
+		}
+
+		if parsed.Hour() == 0 && parsed.Minute() == 0 && parsed.Second() == 0 {
+			return time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC), false, nil
+		}
+		return parsed, true, nil
+	}
+
+	for _, layout := range eventDateLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, p.location); err == nil {
+			return parsed, true, nil
+		}
+	}
+
+	if parsed, err := time.Parse(eventDateOnlyLayout, value); err == nil {
+		return parsed, false, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("%q is not a recognized date", value)
+}
@@ -0,0 +1,172 @@
+//
+// Event Feed Store
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"encoding/xml"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var eventsHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+type FeedEvent struct {
+	Title          string
+	Link           string
+	Description    string
+	ContentEncoded string
+	MediaURL       string
+	MediaType      string
+	PubDate        string
+	EventDate      time.Time
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	DateSource     string
+	GUID           string
+	Categories     []string
+}
+
+// SkippedEvent is a feed item left out because no date could be found for it.
+type SkippedEvent struct {
+	Title  string
+	Link   string
+	GUID   string
+	Reason string
+}
+
+type EventFeed struct {
+	Title       string
+	Link        string
+	Description string
+	Events      []*FeedEvent
+	Skipped     []SkippedEvent
+	FetchedAt   time.Time
+}
+
+type rssRoot struct {
+	XMLName xml.Name   `xml:"rss"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssMediaContent struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title          string          `xml:"title"`
+	Link           string          `xml:"link"`
+	Description    string          `xml:"description"`
+	PubDate        string          `xml:"pubDate"`
+	GUID           string          `xml:"guid"`
+	ContentEncoded string          `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	MediaContent   rssMediaContent `xml:"http://www.rssboard.org/media-rss content"`
+	Categories     []string        `xml:"category"`
+	Extra          []rssExtra      `xml:",any"`
+}
+
+// rssExtra is any other element in an item, kept so a configured element can
+// be read for the event date.
+type rssExtra struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// GetEventFeed returns the cached events feed, fetching it on first use.
+func (s *storeLayer) GetEventFeed() (*EventFeed, error) {
+	return s.events.get()
+}
+
+// RefreshEventFeed fetches the events feed now. The cached copy is kept if the
+// fetch fails.
+func (s *storeLayer) RefreshEventFeed() (*EventFeed, error) {
+	return s.events.refresh(true)
+}
+
+func (p *eventDateParser) parseFeed(body []byte) (*EventFeed, error) {
+	var raw rssRoot
+	if err := xml.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	feed := &EventFeed{
+		Title:       raw.Channel.Title,
+		Link:        raw.Channel.Link,
+		Description: raw.Channel.Description,
+		FetchedAt:   time.Now(),
+	}
+
+	for _, item := range raw.Channel.Items {
+		dates, err := p.parse(&item)
+		if err != nil {
+			slog.Warn("Skipping event without a date",
+				"layer", "store",
+				"entity", "event",
+				"title", item.Title,
+				"link", item.Link,
+				"reason", err.Error(),
+			)
+			feed.Skipped = append(feed.Skipped, SkippedEvent{
+				Title:  item.Title,
+				Link:   item.Link,
+				GUID:   item.GUID,
+				Reason: err.Error(),
+			})
+			continue
+		}
+		feed.Events = append(feed.Events, &FeedEvent{
+			Title:          item.Title,
+			Link:           item.Link,
+			Description:    item.Description,
+			ContentEncoded: item.ContentEncoded,
+			MediaURL:       item.MediaContent.URL,
+			MediaType:      item.MediaContent.Type,
+			PubDate:        item.PubDate,
+			EventDate:      dates.Date,
+			StartsAt:       dates.StartsAt,
+			EndsAt:         dates.EndsAt,
+			DateSource:     dates.Source,
+			GUID:           item.GUID,
+			Categories:     feedCategories(item.Categories),
+		})
+	}
+
+	return feed, nil
+}
+
+// feedCategories tidies the whitespace in an item's categories and drops
+// blank ones.
+func feedCategories(categories []string) []string {
+	result := []string{}
+	for _, category := range categories {
+		if category = strings.Join(strings.Fields(category), " "); len(category) > 0 {
+			result = append(result, category)
+		}
+	}
+
+	return result
+}
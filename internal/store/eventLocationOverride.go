@@ -0,0 +1,78 @@
+//
+// Event Location Override DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// EventLocationOverride pins an event to a location, or to no location when
+// LocationID is nil, instead of matching one. EventKey is the event's GUID,
+// or its link when it has none.
+type EventLocationOverride struct {
+	ID         uint `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	CreatedBy  string `gorm:"not null"`
+	EventKey   string `gorm:"uniqueIndex;not null"`
+	LocationID *uint
+}
+
+func (s *storeLayer) DeleteEventLocationOverride(eventKey string) error {
+	if result := s.db.Where("event_key = ?", eventKey).Delete(&EventLocationOverride{}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) GetAllEventLocationOverrides() (*[]EventLocationOverride, error) {
+	overrides := []EventLocationOverride{}
+
+	if result := s.db.Order("event_key").Find(&overrides); result.Error != nil {
+		return &[]EventLocationOverride{}, result.Error
+	}
+
+	return &overrides, nil
+}
+
+// SetEventLocationOverride creates or replaces the override for an event.
+func (s *storeLayer) SetEventLocationOverride(createdBy, eventKey string, locationId *uint) (*EventLocationOverride, error) {
+	override := EventLocationOverride{
+		CreatedBy:  createdBy,
+		EventKey:   eventKey,
+		LocationID: locationId,
+	}
+
+	result := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "created_by", "location_id"}),
+	}).Create(&override)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	found := EventLocationOverride{}
+	if result := s.db.Where("event_key = ?", eventKey).First(&found); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &found, nil
+}
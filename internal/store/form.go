@@ -35,6 +35,12 @@ type Form struct {
 	ConfirmationEmailSlug      *string
 	NotificationEmailTo        *string
 	NotificationEmailSlug      *string
+	EventStartsAt              *time.Time
+	EventEndsAt                *time.Time
+	EventLocationID            *uint
+	EventSequence              uint `gorm:"not null;default:0"`
+	CalendarInvite             bool `gorm:"not null;default:false"`
+	Reminders                  []FormReminder
 }
 
 func (s *storeLayer) CreateForm(createdBy, name, slug string, opensOn, closesOn *time.Time, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string) (*Form, error) {
@@ -75,7 +81,7 @@ func (s *storeLayer) DeleteForm(id uint) error {
 func (s *storeLayer) GetAllForms() (*[]Form, error) {
 	forms := []Form{}
 
-	if result := s.db.Preload("ViewableBy").Find(&forms); result.Error != nil {
+	if result := s.db.Preload("ViewableBy").Preload("Reminders").Find(&forms); result.Error != nil {
 		return &[]Form{}, result.Error
 	}
 
@@ -85,7 +91,7 @@ func (s *storeLayer) GetAllForms() (*[]Form, error) {
 func (s *storeLayer) GetForm(id uint) (*Form, error) {
 	form := Form{}
 
-	if result := s.db.Preload("ViewableBy").First(&form, id); result.Error != nil {
+	if result := s.db.Preload("ViewableBy").Preload("Reminders").First(&form, id); result.Error != nil {
 		return &Form{}, result.Error
 	}
 
@@ -95,13 +101,60 @@ func (s *storeLayer) GetForm(id uint) (*Form, error) {
 func (s *storeLayer) GetFormWithSlug(slug string) (*Form, error) {
 	form := Form{}
 
-	if result := s.db.Preload("ViewableBy").Where("slug = ?", slug).First(&form); result.Error != nil {
+	if result := s.db.Preload("ViewableBy").Preload("Reminders").Where("slug = ?", slug).First(&form); result.Error != nil {
 		return &Form{}, result.Error
 	}
 
 	return &form, nil
 }
 
+func (s *storeLayer) GetFormsUsingEmail(slug string) (*[]Form, error) {
+	forms := []Form{}
+
+	result := s.db.Preload("Reminders").
+		Where("confirmation_email_slug = ? OR notification_email_slug = ? OR id IN (SELECT form_id FROM form_reminders WHERE email_slug = ?)", slug, slug, slug).
+		Order("name").
+		Find(&forms)
+	if result.Error != nil {
+		return &[]Form{}, result.Error
+	}
+
+	return &forms, nil
+}
+
+// SetFormEvent ties a form to the event it registers people for. The sequence
+// is bumped whenever the event moves so calendar invites sent earlier are
+// replaced by the new one.
+func (s *storeLayer) SetFormEvent(formId uint, startsAt, endsAt *time.Time, locationId *uint, calendarInvite bool) error {
+	form, err := s.GetForm(formId)
+	if err != nil {
+		return err
+	}
+
+	sameTime := func(a, b *time.Time) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && a.Equal(*b))
+	}
+	sameLocation := (form.EventLocationID == nil && locationId == nil) ||
+		(form.EventLocationID != nil && locationId != nil && *form.EventLocationID == *locationId)
+
+	updates := map[string]interface{}{
+		"event_starts_at":   startsAt,
+		"event_ends_at":     endsAt,
+		"event_location_id": locationId,
+		"calendar_invite":   calendarInvite,
+	}
+
+	if !sameTime(form.EventStartsAt, startsAt) || !sameTime(form.EventEndsAt, endsAt) || !sameLocation {
+		updates["event_sequence"] = form.EventSequence + 1
+	}
+
+	if result := s.db.Model(&Form{}).Where("id = ?", formId).Updates(updates); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
 func (s *storeLayer) SetFormViewableBy(formId uint, userIds []uint) error {
 	users := make([]User, len(userIds))
 	for i, id := range userIds {
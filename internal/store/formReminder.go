@@ -0,0 +1,132 @@
+//
+// Form Reminder DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// FormReminder sends an email to every registrant at a fixed offset from the
+// form's event start. Negative offsets are before the event.
+type FormReminder struct {
+	ID            uint `gorm:"primaryKey"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	FormID        uint   `gorm:"index;not null"`
+	EmailSlug     string `gorm:"not null;size:255"`
+	OffsetMinutes int    `gorm:"not null"`
+}
+
+func (r *FormReminder) SendAt(eventStartsAt time.Time) time.Time {
+	return eventStartsAt.Add(time.Duration(r.OffsetMinutes) * time.Minute)
+}
+
+// FormReminderSend records that a reminder went to a submission. The unique
+// index is what keeps a reminder from being sent twice.
+type FormReminderSend struct {
+	ID           uint `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	ReminderID   uint `gorm:"uniqueIndex:idx_form_reminder_sends_reminder_submission;not null"`
+	SubmissionID uint `gorm:"uniqueIndex:idx_form_reminder_sends_reminder_submission;not null"`
+}
+
+// ClaimFormReminderSend records the reminder as sent to the submission and
+// reports whether this call was the one to do so.
+func (s *storeLayer) ClaimFormReminderSend(reminderId, submissionId uint) (bool, error) {
+	send := FormReminderSend{
+		ReminderID:   reminderId,
+		SubmissionID: submissionId,
+	}
+
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&send)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (s *storeLayer) GetAllFormReminders() (*[]FormReminder, error) {
+	reminders := []FormReminder{}
+
+	if result := s.db.Find(&reminders); result.Error != nil {
+		return &[]FormReminder{}, result.Error
+	}
+
+	return &reminders, nil
+}
+
+// GetSubmissionsAwaitingReminder lists the form's submissions the reminder
+// has not been sent to yet. Submissions carry no status, so none are filtered
+// out as cancelled or waitlisted.
+func (s *storeLayer) GetSubmissionsAwaitingReminder(reminderId, formId uint) (*[]Submission, error) {
+	submissions := []Submission{}
+
+	result := s.db.
+		Where("form_id = ?", formId).
+		Where("NOT EXISTS (SELECT 1 FROM form_reminder_sends WHERE form_reminder_sends.reminder_id = ? AND form_reminder_sends.submission_id = submissions.id)", reminderId).
+		Order("id").
+		Find(&submissions)
+	if result.Error != nil {
+		return &[]Submission{}, result.Error
+	}
+
+	return &submissions, nil
+}
+
+// SetFormReminders replaces the form's reminders. Reminders that are kept
+// with the same email and offset keep their ID, so registrants they were
+// already sent to do not get them again.
+func (s *storeLayer) SetFormReminders(formId uint, reminders []FormReminder) error {
+	existing := []FormReminder{}
+	if result := s.db.Where("form_id = ?", formId).Find(&existing); result.Error != nil {
+		return result.Error
+	}
+
+	kept := map[uint]bool{}
+	for _, reminder := range reminders {
+		found := false
+		for _, e := range existing {
+			if !kept[e.ID] && e.EmailSlug == reminder.EmailSlug && e.OffsetMinutes == reminder.OffsetMinutes {
+				kept[e.ID] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			reminder.ID = 0
+			reminder.FormID = formId
+			if result := s.db.Create(&reminder); result.Error != nil {
+				return result.Error
+			}
+		}
+	}
+
+	for _, e := range existing {
+		if !kept[e.ID] {
+			if result := s.db.Delete(&FormReminder{}, e.ID); result.Error != nil {
+				return result.Error
+			}
+		}
+	}
+
+	return nil
+}
@@ -28,9 +28,12 @@ type Location struct {
 	StartTime           string
 	EndTime             string
 	Description         string
+	Latitude            *float64
+	Longitude           *float64
+	Keywords            string
 }
 
-func (s *storeLayer) CreateLocation(createdBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*Location, error) {
+func (s *storeLayer) CreateLocation(createdBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*Location, error) {
 	location := Location{
 		Name:                name,
 		MainImageName:       mainImageName,
@@ -41,6 +44,9 @@ func (s *storeLayer) CreateLocation(createdBy, name, mainImageName, individualIm
 		StartTime:           startTime,
 		EndTime:             endTime,
 		Description:         description,
+		Latitude:            latitude,
+		Longitude:           longitude,
+		Keywords:            keywords,
 	}
 
 	location.CreatedBy = createdBy
@@ -81,7 +87,7 @@ func (s *storeLayer) GetLocation(id uint) (*Location, error) {
 	return &location, nil
 }
 
-func (s *storeLayer) UpdateLocation(id uint, updatedBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*Location, error) {
+func (s *storeLayer) UpdateLocation(id uint, updatedBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*Location, error) {
 	location, err := s.GetLocation(id)
 	if err != nil {
 		return nil, err
@@ -97,6 +103,9 @@ func (s *storeLayer) UpdateLocation(id uint, updatedBy, name, mainImageName, ind
 	location.StartTime = startTime
 	location.EndTime = endTime
 	location.Description = description
+	location.Latitude = latitude
+	location.Longitude = longitude
+	location.Keywords = keywords
 
 	if result := s.db.Save(&location); result.Error != nil {
 		return nil, result.Error
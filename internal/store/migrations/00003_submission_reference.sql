@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE submissions ADD COLUMN IF NOT EXISTS reference varchar(16);
+ALTER TABLE submissions ADD COLUMN IF NOT EXISTS idempotency_key varchar(255);
+
+-- Existing submissions get a six character code so they can never collide
+-- with the five character codes generated for new submissions.
+UPDATE submissions SET reference = 'OC-' || CASE
+    WHEN length(to_hex(id)) < 6 THEN lpad(upper(to_hex(id)), 6, '0')
+    ELSE upper(to_hex(id))
+END WHERE reference IS NULL;
+
+ALTER TABLE submissions ALTER COLUMN reference SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_submissions_reference ON submissions (reference);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_submissions_idempotency_key ON submissions (form_id, idempotency_key);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_submissions_idempotency_key;
+DROP INDEX IF EXISTS idx_submissions_reference;
+ALTER TABLE submissions DROP COLUMN IF EXISTS idempotency_key;
+ALTER TABLE submissions DROP COLUMN IF EXISTS reference;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS outbound_emails (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    email_slug varchar(255) NOT NULL,
+    "to" text NOT NULL,
+    subject text NOT NULL,
+    html_body text NOT NULL,
+    text_body text NOT NULL,
+    status varchar(16) NOT NULL,
+    attempts bigint NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error text,
+    sent_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_outbound_emails_status ON outbound_emails (status);
+CREATE INDEX IF NOT EXISTS idx_outbound_emails_next_attempt_at ON outbound_emails (next_attempt_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbound_emails;
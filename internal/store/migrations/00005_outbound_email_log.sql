@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS form_id bigint;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS submission_id bigint;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS provider_message_id text;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS last_attempt_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_outbound_emails_form_id ON outbound_emails (form_id);
+CREATE INDEX IF NOT EXISTS idx_outbound_emails_submission_id ON outbound_emails (submission_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_outbound_emails_submission_id;
+DROP INDEX IF EXISTS idx_outbound_emails_form_id;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS last_attempt_at;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS provider_message_id;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS submission_id;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS form_id;
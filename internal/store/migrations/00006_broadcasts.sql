@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS broadcasts (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    created_by text NOT NULL,
+    updated_by text,
+    deleted_by text,
+    email_slug varchar(255) NOT NULL,
+    form_id bigint NOT NULL,
+    filters text,
+    recipient_count bigint NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_broadcasts_deleted_at ON broadcasts (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_broadcasts_form_id ON broadcasts (form_id);
+
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS broadcast_id bigint;
+CREATE INDEX IF NOT EXISTS idx_outbound_emails_broadcast_id ON outbound_emails (broadcast_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_outbound_emails_broadcast_id;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS broadcast_id;
+DROP TABLE IF EXISTS broadcasts;
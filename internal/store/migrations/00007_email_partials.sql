@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS email_partials (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    created_by text NOT NULL,
+    updated_by text,
+    deleted_by text,
+    name text NOT NULL,
+    slug varchar(255) NOT NULL,
+    layout boolean NOT NULL DEFAULT false,
+    html_body text NOT NULL,
+    text_body text NOT NULL,
+    CONSTRAINT uni_email_partials_slug UNIQUE (slug)
+);
+CREATE INDEX IF NOT EXISTS idx_email_partials_deleted_at ON email_partials (deleted_at);
+
+ALTER TABLE emails ADD COLUMN IF NOT EXISTS layout_slug varchar(255);
+
+-- +goose Down
+ALTER TABLE emails DROP COLUMN IF EXISTS layout_slug;
+DROP TABLE IF EXISTS email_partials;
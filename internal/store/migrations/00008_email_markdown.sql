@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE emails ADD COLUMN IF NOT EXISTS markdown_body text;
+
+-- +goose Down
+ALTER TABLE emails DROP COLUMN IF EXISTS markdown_body;
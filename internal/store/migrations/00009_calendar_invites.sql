@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE forms ADD COLUMN IF NOT EXISTS event_starts_at timestamptz;
+ALTER TABLE forms ADD COLUMN IF NOT EXISTS event_ends_at timestamptz;
+ALTER TABLE forms ADD COLUMN IF NOT EXISTS event_location_id bigint;
+ALTER TABLE forms ADD COLUMN IF NOT EXISTS event_sequence bigint NOT NULL DEFAULT 0;
+ALTER TABLE forms ADD COLUMN IF NOT EXISTS calendar_invite boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS outbound_email_attachments (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    outbound_email_id bigint NOT NULL,
+    filename text NOT NULL,
+    content_type text NOT NULL,
+    content bytea NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_outbound_email_attachments_outbound_email_id ON outbound_email_attachments (outbound_email_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbound_email_attachments;
+ALTER TABLE forms DROP COLUMN IF EXISTS calendar_invite;
+ALTER TABLE forms DROP COLUMN IF EXISTS event_sequence;
+ALTER TABLE forms DROP COLUMN IF EXISTS event_location_id;
+ALTER TABLE forms DROP COLUMN IF EXISTS event_ends_at;
+ALTER TABLE forms DROP COLUMN IF EXISTS event_starts_at;
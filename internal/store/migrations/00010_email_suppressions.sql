@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS email_suppressions (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    address varchar(320) NOT NULL,
+    reason varchar(32) NOT NULL,
+    CONSTRAINT uni_email_suppressions_address UNIQUE (address)
+);
+
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS bulk boolean NOT NULL DEFAULT false;
+UPDATE outbound_emails SET bulk = true WHERE broadcast_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS bulk;
+DROP TABLE IF EXISTS email_suppressions;
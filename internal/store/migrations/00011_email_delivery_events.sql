@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS delivered_at timestamptz;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS opened_at timestamptz;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS bounced_at timestamptz;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS complained_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_outbound_emails_provider_message_id ON outbound_emails (provider_message_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_outbound_emails_provider_message_id;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS complained_at;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS bounced_at;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS opened_at;
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS delivered_at;
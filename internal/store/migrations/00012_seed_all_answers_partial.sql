@@ -0,0 +1,17 @@
+-- +goose Up
+INSERT INTO email_partials (created_at, updated_at, created_by, updated_by, name, slug, layout, html_body, text_body)
+SELECT NOW(), NOW(), 'system', 'system', 'All answers', 'all-answers', false,
+$$<table cellpadding="6" cellspacing="0" style="border-collapse: collapse;">
+{{- range answers .Fields .Values}}
+  <tr>
+    <th align="left" valign="top" style="border-bottom: 1px solid #ddd;">{{.Label}}</th>
+    <td valign="top" style="border-bottom: 1px solid #ddd;">{{default "—" .Value}}</td>
+  </tr>
+{{- end}}
+</table>$$,
+$${{range answers .Fields .Values}}{{.Label}}: {{default "—" .Value}}
+{{end}}$$
+WHERE NOT EXISTS (SELECT 1 FROM email_partials WHERE slug = 'all-answers');
+
+-- +goose Down
+DELETE FROM email_partials WHERE slug = 'all-answers' AND created_by = 'system';
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS email_revisions (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    created_by text NOT NULL,
+    email_id bigint NOT NULL,
+    revision bigint NOT NULL,
+    name text NOT NULL,
+    slug varchar(255) NOT NULL,
+    layout_slug varchar(255),
+    subject text NOT NULL,
+    markdown_body text,
+    html_body text NOT NULL,
+    text_body text NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_email_revisions_email_revision ON email_revisions (email_id, revision);
+
+ALTER TABLE emails ADD COLUMN IF NOT EXISTS revision bigint NOT NULL DEFAULT 0;
+ALTER TABLE outbound_emails ADD COLUMN IF NOT EXISTS email_revision bigint NOT NULL DEFAULT 0;
+
+-- Existing emails start their history at revision one as they are now.
+UPDATE emails SET revision = 1 WHERE revision = 0;
+INSERT INTO email_revisions (created_at, created_by, email_id, revision, name, slug, layout_slug, subject, markdown_body, html_body, text_body)
+SELECT COALESCE(updated_at, created_at, NOW()), COALESCE(updated_by, created_by), id, revision, name, slug, layout_slug, subject, markdown_body, html_body, text_body
+FROM emails
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM email_revisions r WHERE r.email_id = emails.id);
+
+-- +goose Down
+ALTER TABLE outbound_emails DROP COLUMN IF EXISTS email_revision;
+ALTER TABLE emails DROP COLUMN IF EXISTS revision;
+DROP TABLE IF EXISTS email_revisions;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS form_reminders (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    form_id bigint NOT NULL,
+    email_slug varchar(255) NOT NULL,
+    offset_minutes bigint NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_form_reminders_form_id ON form_reminders (form_id);
+
+CREATE TABLE IF NOT EXISTS form_reminder_sends (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    reminder_id bigint NOT NULL,
+    submission_id bigint NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_form_reminder_sends_reminder_submission ON form_reminder_sends (reminder_id, submission_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS form_reminder_sends;
+DROP TABLE IF EXISTS form_reminders;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS digest_preferences (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    user_id bigint NOT NULL,
+    enabled boolean NOT NULL DEFAULT false,
+    weekday bigint NOT NULL DEFAULT 1,
+    hour bigint NOT NULL DEFAULT 8,
+    last_sent_at timestamptz,
+    CONSTRAINT uni_digest_preferences_user_id UNIQUE (user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS digest_preferences;
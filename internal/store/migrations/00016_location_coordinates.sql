@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS latitude double precision;
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS longitude double precision;
+
+-- +goose Down
+ALTER TABLE locations DROP COLUMN IF EXISTS longitude;
+ALTER TABLE locations DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS keywords text NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS event_location_overrides (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    created_by text NOT NULL,
+    event_key text NOT NULL,
+    location_id bigint,
+    CONSTRAINT uni_event_location_overrides_event_key UNIQUE (event_key)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS event_location_overrides;
+ALTER TABLE locations DROP COLUMN IF EXISTS keywords;
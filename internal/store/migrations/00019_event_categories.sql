@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE events ADD COLUMN IF NOT EXISTS categories text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE events DROP COLUMN IF EXISTS categories;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- A form belongs to at most one event, which owns the form's event time and
+-- location. Extra links are dropped, keeping the oldest event.
+UPDATE events SET form_id = NULL
+WHERE id IN (
+    SELECT id FROM (
+        SELECT id, row_number() OVER (PARTITION BY form_id ORDER BY id) AS rn
+        FROM events
+        WHERE form_id IS NOT NULL AND deleted_at IS NULL
+    ) linked
+    WHERE rn > 1
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_form_id ON events (form_id) WHERE form_id IS NOT NULL AND deleted_at IS NULL;
+
+UPDATE forms SET
+    event_starts_at = events.starts_at,
+    event_ends_at = events.ends_at,
+    event_location_id = events.location_id,
+    event_sequence = forms.event_sequence + 1
+FROM events
+WHERE events.form_id = forms.id
+  AND events.deleted_at IS NULL
+  AND (forms.event_starts_at IS DISTINCT FROM events.starts_at
+    OR forms.event_ends_at IS DISTINCT FROM events.ends_at
+    OR forms.event_location_id IS DISTINCT FROM events.location_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_form_id;
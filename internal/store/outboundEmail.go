@@ -0,0 +1,343 @@
+//
+// Outbound Email DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	OutboundEmailPending = "pending"
+	OutboundEmailSending = "sending"
+	OutboundEmailSent    = "sent"
+	OutboundEmailFailed  = "failed"
+
+	// OutboundEmailSuppressed marks bulk email that was not sent because the
+	// recipient is on the suppression list.
+	OutboundEmailSuppressed = "suppressed"
+)
+
+type OutboundEmail struct {
+	ID                uint `gorm:"primaryKey"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	EmailSlug         string `gorm:"not null;size:255"`
+	EmailRevision     uint   `gorm:"not null;default:0"`
+	FormID            *uint  `gorm:"index"`
+	SubmissionID      *uint  `gorm:"index"`
+	BroadcastID       *uint  `gorm:"index"`
+	Bulk              bool   `gorm:"not null;default:false"`
+	To                string `gorm:"not null"`
+	Subject           string `gorm:"not null"`
+	HtmlBody          string `gorm:"not null"`
+	TextBody          string `gorm:"not null"`
+	Status            string `gorm:"index;not null;size:16"`
+	Attempts          uint   `gorm:"not null;default:0"`
+	ProviderMessageID *string
+	NextAttemptAt     time.Time `gorm:"index;not null"`
+	LastAttemptAt     *time.Time
+	LastError         *string
+	SentAt            *time.Time
+	DeliveredAt       *time.Time
+	OpenedAt          *time.Time
+	BouncedAt         *time.Time
+	ComplainedAt      *time.Time
+}
+
+func (o *OutboundEmail) Recipients() []string {
+	return strings.Split(o.To, ",")
+}
+
+// ClaimOutboundEmails locks up to limit messages that are due and marks them
+// as sending. Messages whose lease runs out before they are resolved, for
+// example because the process died mid-send, become due again.
+func (s *storeLayer) ClaimOutboundEmails(limit int, lease time.Duration) (*[]OutboundEmail, error) {
+	claimed := []OutboundEmail{}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND next_attempt_at <= ?", []string{OutboundEmailPending, OutboundEmailSending}, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&claimed)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for i := range claimed {
+			claimed[i].Status = OutboundEmailSending
+			claimed[i].Attempts++
+			claimed[i].LastAttemptAt = &now
+			claimed[i].NextAttemptAt = now.Add(lease)
+
+			if result := tx.Save(&claimed[i]); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return &[]OutboundEmail{}, err
+	}
+
+	return &claimed, nil
+}
+
+func (s *storeLayer) CreateOutboundEmail(emailSlug string, emailRevision uint, formId, submissionId *uint, to []string, subject, htmlBody, textBody string) (*OutboundEmail, error) {
+	outboundEmail := OutboundEmail{
+		EmailSlug:     emailSlug,
+		EmailRevision: emailRevision,
+		FormID:        formId,
+		SubmissionID:  submissionId,
+		To:            strings.Join(to, ","),
+		Subject:       subject,
+		HtmlBody:      htmlBody,
+		TextBody:      textBody,
+		Status:        OutboundEmailPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	if result := s.db.Create(&outboundEmail); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &outboundEmail, nil
+}
+
+// CreateBroadcastEmail queues one recipient's copy of a broadcast. sendAt lets
+// a large broadcast be spread out instead of handed to the worker at once.
+func (s *storeLayer) CreateBroadcastEmail(broadcastId uint, emailSlug string, emailRevision uint, formId, submissionId uint, to, subject, htmlBody, textBody string, sendAt time.Time) (*OutboundEmail, error) {
+	outboundEmail := OutboundEmail{
+		EmailSlug:     emailSlug,
+		EmailRevision: emailRevision,
+		FormID:        &formId,
+		SubmissionID:  &submissionId,
+		BroadcastID:   &broadcastId,
+		Bulk:          true,
+		To:            to,
+		Subject:       subject,
+		HtmlBody:      htmlBody,
+		TextBody:      textBody,
+		Status:        OutboundEmailPending,
+		NextAttemptAt: sendAt,
+	}
+
+	if result := s.db.Create(&outboundEmail); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &outboundEmail, nil
+}
+
+// CreateBulkEmail queues one recipient's copy of bulk email that is not part
+// of a broadcast, such as a form reminder.
+func (s *storeLayer) CreateBulkEmail(emailSlug string, emailRevision uint, formId, submissionId uint, to, subject, htmlBody, textBody string) (*OutboundEmail, error) {
+	outboundEmail := OutboundEmail{
+		EmailSlug:     emailSlug,
+		EmailRevision: emailRevision,
+		FormID:        &formId,
+		SubmissionID:  &submissionId,
+		Bulk:          true,
+		To:            to,
+		Subject:       subject,
+		HtmlBody:      htmlBody,
+		TextBody:      textBody,
+		Status:        OutboundEmailPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	if result := s.db.Create(&outboundEmail); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &outboundEmail, nil
+}
+
+// CountOutboundEmailsForBroadcast returns how many of a broadcast's messages
+// are in each status.
+func (s *storeLayer) CountOutboundEmailsForBroadcast(broadcastId uint) (map[string]int64, error) {
+	rows := []struct {
+		Status string
+		Count  int64
+	}{}
+
+	result := s.db.Model(&OutboundEmail{}).
+		Select("status, count(*) AS count").
+		Where("broadcast_id = ?", broadcastId).
+		Group("status").
+		Scan(&rows)
+	if result.Error != nil {
+		return map[string]int64{}, result.Error
+	}
+
+	counts := map[string]int64{}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (s *storeLayer) GetOutboundEmail(id uint) (*OutboundEmail, error) {
+	outboundEmail := OutboundEmail{}
+
+	if result := s.db.First(&outboundEmail, id); result.Error != nil {
+		return &OutboundEmail{}, result.Error
+	}
+
+	return &outboundEmail, nil
+}
+
+func (s *storeLayer) GetOutboundEmailWithProviderMessageID(providerMessageId string) (*OutboundEmail, error) {
+	outboundEmail := OutboundEmail{}
+
+	if result := s.db.Where("provider_message_id = ?", providerMessageId).First(&outboundEmail); result.Error != nil {
+		return &OutboundEmail{}, result.Error
+	}
+
+	return &outboundEmail, nil
+}
+
+func (s *storeLayer) GetOutboundEmails(status string, formId, submissionId *uint, recipient string) (*[]OutboundEmail, error) {
+	outboundEmails := []OutboundEmail{}
+
+	query := s.db.Order("created_at DESC")
+	if len(status) > 0 {
+		query = query.Where("status = ?", status)
+	}
+	if formId != nil {
+		query = query.Where("form_id = ?", *formId)
+	}
+	if submissionId != nil {
+		query = query.Where("submission_id = ?", *submissionId)
+	}
+	if len(recipient) > 0 {
+		query = query.Where("lower(?) = ANY(string_to_array(lower(\"to\"), ','))", recipient)
+	}
+
+	if result := query.Find(&outboundEmails); result.Error != nil {
+		return &[]OutboundEmail{}, result.Error
+	}
+
+	return &outboundEmails, nil
+}
+
+func (s *storeLayer) MarkOutboundEmailBounced(id uint, bouncedAt time.Time, detail string) error {
+	updates := map[string]interface{}{
+		"bounced_at": bouncedAt,
+		"last_error": detail,
+	}
+
+	if result := s.db.Model(&OutboundEmail{ID: id}).Updates(updates); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) MarkOutboundEmailComplained(id uint, complainedAt time.Time) error {
+	if result := s.db.Model(&OutboundEmail{ID: id}).Update("complained_at", complainedAt); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) MarkOutboundEmailDelivered(id uint, deliveredAt time.Time) error {
+	if result := s.db.Model(&OutboundEmail{ID: id}).Update("delivered_at", deliveredAt); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) MarkOutboundEmailFailed(id uint, lastError string, nextAttemptAt *time.Time) error {
+	updates := map[string]interface{}{
+		"last_error": lastError,
+	}
+
+	if nextAttemptAt == nil {
+		updates["status"] = OutboundEmailFailed
+	} else {
+		updates["status"] = OutboundEmailPending
+		updates["next_attempt_at"] = *nextAttemptAt
+	}
+
+	if result := s.db.Model(&OutboundEmail{ID: id}).Updates(updates); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) MarkOutboundEmailSent(id uint, providerMessageId string) error {
+	updates := map[string]interface{}{
+		"status":              OutboundEmailSent,
+		"provider_message_id": providerMessageId,
+		"sent_at":             time.Now(),
+	}
+
+	if result := s.db.Model(&OutboundEmail{ID: id}).Updates(updates); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// MarkOutboundEmailOpened records the first time a message was opened; later
+// opens are ignored.
+func (s *storeLayer) MarkOutboundEmailOpened(id uint, openedAt time.Time) error {
+	if result := s.db.Model(&OutboundEmail{ID: id}).Where("opened_at IS NULL").Update("opened_at", openedAt); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) MarkOutboundEmailSuppressed(id uint) error {
+	if result := s.db.Model(&OutboundEmail{ID: id}).Update("status", OutboundEmailSuppressed); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (s *storeLayer) RetryOutboundEmail(id uint) (*OutboundEmail, error) {
+	outboundEmail, err := s.GetOutboundEmail(id)
+	if err != nil {
+		return nil, err
+	}
+
+	outboundEmail.Status = OutboundEmailPending
+	outboundEmail.Attempts = 0
+	outboundEmail.NextAttemptAt = time.Now()
+
+	if result := s.db.Save(&outboundEmail); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return outboundEmail, nil
+}
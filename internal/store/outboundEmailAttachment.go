@@ -0,0 +1,54 @@
+//
+// Outbound Email Attachment DB Object
+// Copyright 2026 OutClimb
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import "time"
+
+type OutboundEmailAttachment struct {
+	ID              uint `gorm:"primaryKey"`
+	CreatedAt       time.Time
+	OutboundEmailID uint   `gorm:"index;not null"`
+	Filename        string `gorm:"not null"`
+	ContentType     string `gorm:"not null"`
+	Content         []byte `gorm:"not null"`
+}
+
+func (s *storeLayer) CreateOutboundEmailAttachment(outboundEmailId uint, filename, contentType string, content []byte) (*OutboundEmailAttachment, error) {
+	attachment := OutboundEmailAttachment{
+		OutboundEmailID: outboundEmailId,
+		Filename:        filename,
+		ContentType:     contentType,
+		Content:         content,
+	}
+
+	if result := s.db.Create(&attachment); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &attachment, nil
+}
+
+func (s *storeLayer) GetOutboundEmailAttachments(outboundEmailId uint) (*[]OutboundEmailAttachment, error) {
+	attachments := []OutboundEmailAttachment{}
+
+	if result := s.db.Where("outbound_email_id = ?", outboundEmailId).Order("id").Find(&attachments); result.Error != nil {
+		return &[]OutboundEmailAttachment{}, result.Error
+	}
+
+	return &attachments, nil
+}
@@ -20,6 +20,7 @@ package store
 import (
 	"context"
 	"embed"
+	"errors"
 	"log/slog"
 	"os"
 	"time"
@@ -34,26 +35,44 @@ import (
 	"gorm.io/gorm"
 )
 
-var Entities = [...]string{"asset", "email", "form", "redirect", "location", "social", "user", "role"}
+var Entities = [...]string{"asset", "email", "event", "form", "redirect", "location", "social", "user", "role"}
 
 //go:embed migrations/*.sql
 var migrations embed.FS
 
 type StoreLayer interface {
+	ClaimDigest(id uint, scheduledAt time.Time) (bool, error)
+	ClaimFormReminderSend(reminderId, submissionId uint) (bool, error)
+	ClaimOutboundEmails(limit int, lease time.Duration) (*[]OutboundEmail, error)
+	CountOutboundEmailsForBroadcast(broadcastId uint) (map[string]int64, error)
 	CountSubmissionsForForm(formId uint) (int64, error)
+	CountSubmissionsForFormSince(formId uint, since time.Time) (int64, error)
 	CreateAsset(createdBy, filename, key, contentType, data string) (*Asset, error)
-	CreateEmail(createdBy, name, slug, subject, htmlBody, textBody string) (*Email, error)
+	CreateBroadcast(createdBy, emailSlug string, formId uint, filters *string, recipientCount uint) (*Broadcast, error)
+	CreateBroadcastEmail(broadcastId uint, emailSlug string, emailRevision uint, formId, submissionId uint, to, subject, htmlBody, textBody string, sendAt time.Time) (*OutboundEmail, error)
+	CreateBulkEmail(emailSlug string, emailRevision uint, formId, submissionId uint, to, subject, htmlBody, textBody string) (*OutboundEmail, error)
+	CreateEmail(createdBy, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*Email, error)
+	CreateEmailPartial(createdBy, name, slug string, layout bool, htmlBody, textBody string) (*EmailPartial, error)
+	CreateEmailRevision(email *Email) (*EmailRevision, error)
+	CreateEmailSuppression(address, reason string) (*EmailSuppression, error)
+	CreateEvent(createdBy, title string, startsAt time.Time, endsAt *time.Time, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories string) (*Event, error)
 	CreateForm(createdBy, name, slug string, opensOn, closesOn *time.Time, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string) (*Form, error)
 	CreateFormField(createdBy string, formId uint, name, slug, fieldType string, metadata, validation *string, required bool, order uint) (*FormField, error)
-	CreateLocation(createdBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*Location, error)
+	CreateLocation(createdBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*Location, error)
+	CreateOutboundEmail(emailSlug string, emailRevision uint, formId, submissionId *uint, to []string, subject, htmlBody, textBody string) (*OutboundEmail, error)
+	CreateOutboundEmailAttachment(outboundEmailId uint, filename, contentType string, content []byte) (*OutboundEmailAttachment, error)
 	CreatePermission(roleId uint, level PermissionLevel, entity string) (*Permission, error)
 	CreateRedirect(createdBy, fromPath, toUrl string, startsOn, stopsOn *time.Time) (*Redirect, error)
 	CreateRole(createdBy, name string, order uint) (*Role, error)
-	CreateSubmission(formId uint) (*Submission, error)
+	CreateSubmission(formId uint, reference string, idempotencyKey *string) (*Submission, error)
 	CreateSubmissionValue(submissionId, formFieldId uint, value string) (*SubmissionValue, error)
 	CreateUser(createdBy string, disabled bool, email, name, password string, requirePasswordReset bool, username string, roleId uint) (*User, error)
 	DeleteAsset(id uint) error
 	DeleteEmail(id uint) error
+	DeleteEmailPartial(id uint) error
+	DeleteEmailSuppression(id uint) error
+	DeleteEvent(id uint) error
+	DeleteEventLocationOverride(eventKey string) error
 	DeleteForm(id uint) error
 	DeleteFormField(id uint) error
 	DeleteFormFieldForForm(formId uint) error
@@ -68,10 +87,15 @@ type StoreLayer interface {
 	DeleteSubmissionValuesForSubmission(submissionId uint) error
 	DeleteUser(id uint) error
 	FindActiveRedirectByPath(path string) (*Redirect, error)
-	GetAllEvents() (*EventFeed, error)
 	FindAsset(fileName string) (string, error)
 	GetAllAssets() (*[]Asset, error)
+	GetAllBroadcasts() (*[]Broadcast, error)
+	GetAllEmailPartials() (*[]EmailPartial, error)
 	GetAllEmails() (*[]Email, error)
+	GetAllEmailSuppressions() (*[]EmailSuppression, error)
+	GetAllEventLocationOverrides() (*[]EventLocationOverride, error)
+	GetAllEvents() (*[]Event, error)
+	GetAllFormReminders() (*[]FormReminder, error)
 	GetAllForms() (*[]Form, error)
 	GetAllFormFields() (*[]FormField, error)
 	GetAllFormFieldsForForm(formId uint) (*[]FormField, error)
@@ -84,30 +108,68 @@ type StoreLayer interface {
 	GetAllSubmissionValueForSubmission(submissionId uint) (*[]SubmissionValue, error)
 	GetAllUsers() (*[]User, error)
 	GetAsset(id uint) (*Asset, error)
+	GetAssets(ids []uint) (*[]Asset, error)
+	GetBroadcast(id uint) (*Broadcast, error)
+	GetDigestPreferenceForUser(userId uint) (*DigestPreference, error)
 	GetEmail(id uint) (*Email, error)
+	GetEmailPartial(id uint) (*EmailPartial, error)
+	GetEmailPartialWithSlug(slug string) (*EmailPartial, error)
+	GetEmailRevision(emailId, revision uint) (*EmailRevision, error)
+	GetEmailRevisions(emailId uint) (*[]EmailRevision, error)
+	GetEmailSuppression(id uint) (*EmailSuppression, error)
+	GetEmailSuppressionWithAddress(address string) (*EmailSuppression, error)
+	GetEmailSuppressionsForAddresses(addresses []string) (*[]EmailSuppression, error)
 	GetEmailWithSlug(slug string) (*Email, error)
+	GetEnabledDigestPreferences() (*[]DigestPreference, error)
+	GetEvent(id uint) (*Event, error)
+	GetEventFeed() (*EventFeed, error)
+	GetEventForForm(formId uint) (*Event, error)
 	GetForm(id uint) (*Form, error)
 	GetFormField(id uint) (*FormField, error)
+	GetFormsUsingEmail(slug string) (*[]Form, error)
 	GetFormWithSlug(slug string) (*Form, error)
 	GetLocation(id uint) (*Location, error)
+	GetOutboundEmail(id uint) (*OutboundEmail, error)
+	GetOutboundEmailAttachments(outboundEmailId uint) (*[]OutboundEmailAttachment, error)
+	GetOutboundEmailWithProviderMessageID(providerMessageId string) (*OutboundEmail, error)
+	GetOutboundEmails(status string, formId, submissionId *uint, recipient string) (*[]OutboundEmail, error)
 	GetPermission(id uint) (*Permission, error)
 	GetPermissionsWithRole(roleId uint) (*[]Permission, error)
 	GetPermissionWithRoleAndAccess(roleId, accessId uint) (*Permission, error)
+	GetPublishedEvents() (*[]Event, error)
 	GetRedirect(id uint) (*Redirect, error)
 	GetRole(id uint) (*Role, error)
 	GetRoleWithName(name string) (*Role, error)
 	GetSubmission(id uint) (*Submission, error)
+	GetSubmissionsAwaitingReminder(reminderId, formId uint) (*[]Submission, error)
 	GetSubmissionsForForm(formId uint) (*[]Submission, error)
+	GetSubmissionWithIdempotencyKey(formId uint, idempotencyKey string) (*Submission, error)
+	GetSubmissionWithReference(reference string) (*Submission, error)
 	GetSubmissionValue(id uint) (*SubmissionValue, error)
 	GetUser(id uint) (*User, error)
 	GetUsersWithRole(roleId uint) (*[]User, error)
 	GetUserWithUsername(username string) (*User, error)
+	MarkOutboundEmailBounced(id uint, bouncedAt time.Time, detail string) error
+	MarkOutboundEmailComplained(id uint, complainedAt time.Time) error
+	MarkOutboundEmailDelivered(id uint, deliveredAt time.Time) error
+	MarkOutboundEmailFailed(id uint, lastError string, nextAttemptAt *time.Time) error
+	MarkOutboundEmailOpened(id uint, openedAt time.Time) error
+	MarkOutboundEmailSent(id uint, providerMessageId string) error
+	MarkOutboundEmailSuppressed(id uint) error
+	RefreshEventFeed() (*EventFeed, error)
+	RetryOutboundEmail(id uint) (*OutboundEmail, error)
+	SetDigestPreference(userId uint, enabled bool, weekday, hour uint, lastSentAt *time.Time) (*DigestPreference, error)
+	SetEventLocationOverride(createdBy, eventKey string, locationId *uint) (*EventLocationOverride, error)
+	SetFormEvent(formId uint, startsAt, endsAt *time.Time, locationId *uint, calendarInvite bool) error
+	SetFormReminders(formId uint, reminders []FormReminder) error
 	SetFormViewableBy(formId uint, userIds []uint) error
 	UpdateAsset(id uint, updatedBy, filename, contentType, data string) (*Asset, error)
-	UpdateEmail(id uint, updatedBy, name, slug, subject, htmlBody, textBody string) (*Email, error)
+	UpdateEmail(id uint, updatedBy, name, slug string, layoutSlug *string, subject string, markdownBody *string, htmlBody, textBody string) (*Email, error)
+	UpdateEmailPartial(id uint, updatedBy, name, slug string, layout bool, htmlBody, textBody string) (*EmailPartial, error)
+	UpdateEvent(id uint, updatedBy, title string, startsAt time.Time, endsAt *time.Time, locationId, imageAssetId *uint, description string, formId *uint, link string, published bool, categories string) (*Event, error)
 	UpdateForm(id uint, updatedBy, name, slug string, opensOn, closesOn *time.Time, maxSubmissions *uint, notOpenMessage, closedMessage, filledMessage, successMessage, confirmationEmailFieldSlug, confirmationEmailSlug, notificationEmailTo, notificationEmailSlug *string) (*Form, error)
 	UpdateFormField(id uint, updatedBy, name, slug, fieldType string, metadata, validation *string, required bool, order uint) (*FormField, error)
-	UpdateLocation(id uint, updatedBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string) (*Location, error)
+	UpdateLocation(id uint, updatedBy, name, mainImageName, individualImageName, backgroundImagePath, color, address, startTime, endTime, description string, latitude, longitude *float64, keywords string) (*Location, error)
 	UpdatePermission(id uint, level PermissionLevel) (*Permission, error)
 	UpdatePassword(id uint, password, updatedBy string) error
 	UpdateRedirect(id uint, updatedBy, fromPath, toUrl string, startsOn, stopsOn *time.Time) (*Redirect, error)
@@ -119,6 +181,7 @@ type StoreLayer interface {
 
 type storeLayer struct {
 	db            *gorm.DB
+	events        *eventCache
 	s3            *s3.Client
 	storageConfig *utils.StorageConfig
 	storeConfig   *utils.StoreConfig
@@ -179,6 +242,7 @@ func New(databaseConfig *utils.DatabaseConfig, storeConfig *utils.StoreConfig, s
 
 	return &storeLayer{
 		db:            db,
+		events:        newEventCache(storeConfig.EventsRSSURL, parseEventsCacheTTL(storeConfig.EventsCacheTTL), newEventDateParser(storeConfig), eventsHTTPClient),
 		s3:            client,
 		storageConfig: storageConfig,
 		storeConfig:   storeConfig,
@@ -221,6 +285,12 @@ func (s *storeLayer) Migrate() {
 
 func (s *storeLayer) WithTransaction(fn func(StoreLayer) error) error {
 	return s.db.Transaction(func(tx *gorm.DB) error {
-		return fn(&storeLayer{db: tx, s3: s.s3, storageConfig: s.storageConfig, storeConfig: s.storeConfig})
+		return fn(&storeLayer{db: tx, events: s.events, s3: s.s3, storageConfig: s.storageConfig, storeConfig: s.storeConfig})
 	})
 }
+
+// IsNotFound reports whether err means the requested row does not exist, as
+// opposed to the lookup itself failing.
+func IsNotFound(err error) bool {
+	return errors.Is(err, gorm.ErrRecordNotFound)
+}
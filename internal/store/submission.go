@@ -20,9 +20,11 @@ package store
 import "time"
 
 type Submission struct {
-	ID          uint `gorm:"primaryKey"`
-	FormID      uint
-	SubmittedOn time.Time
+	ID             uint `gorm:"primaryKey"`
+	FormID         uint
+	SubmittedOn    time.Time
+	Reference      string  `gorm:"uniqueIndex;not null;size:16"`
+	IdempotencyKey *string `gorm:"size:255"`
 }
 
 func (s *storeLayer) CountSubmissionsForForm(formId uint) (int64, error) {
@@ -35,10 +37,22 @@ func (s *storeLayer) CountSubmissionsForForm(formId uint) (int64, error) {
 	return count, nil
 }
 
-func (s *storeLayer) CreateSubmission(formId uint) (*Submission, error) {
+func (s *storeLayer) CountSubmissionsForFormSince(formId uint, since time.Time) (int64, error) {
+	var count int64
+
+	if result := s.db.Model(&Submission{}).Where("form_id = ? AND submitted_on >= ?", formId, since).Count(&count); result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+func (s *storeLayer) CreateSubmission(formId uint, reference string, idempotencyKey *string) (*Submission, error) {
 	submission := Submission{
-		FormID:      formId,
-		SubmittedOn: time.Now(),
+		FormID:         formId,
+		SubmittedOn:    time.Now(),
+		Reference:      reference,
+		IdempotencyKey: idempotencyKey,
 	}
 
 	if result := s.db.Create(&submission); result.Error != nil {
@@ -84,6 +98,26 @@ func (s *storeLayer) GetSubmission(id uint) (*Submission, error) {
 	return &submission, nil
 }
 
+func (s *storeLayer) GetSubmissionWithIdempotencyKey(formId uint, idempotencyKey string) (*Submission, error) {
+	submission := Submission{}
+
+	if result := s.db.Where("form_id = ? AND idempotency_key = ?", formId, idempotencyKey).First(&submission); result.Error != nil {
+		return &Submission{}, result.Error
+	}
+
+	return &submission, nil
+}
+
+func (s *storeLayer) GetSubmissionWithReference(reference string) (*Submission, error) {
+	submission := Submission{}
+
+	if result := s.db.Where("reference = ?", reference).First(&submission); result.Error != nil {
+		return &Submission{}, result.Error
+	}
+
+	return &submission, nil
+}
+
 func (s *storeLayer) GetSubmissionsForForm(formId uint) (*[]Submission, error) {
 	submissions := []Submission{}
 
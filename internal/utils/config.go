@@ -28,12 +28,23 @@ import (
 )
 
 type AppConfig struct {
+	AssetsURL              string `mapstructure:"OC_ASSETS_URL"`
+	BroadcastRatePerMinute int    `mapstructure:"OC_BROADCAST_RATE_PER_MINUTE"`
+	DigestWorkerInterval   string `mapstructure:"OC_DIGEST_WORKER_INTERVAL"`
 	EmailFromAddress       string `mapstructure:"OC_EMAIL_FROM_ADDRESS"`
+	EmailMaxAttempts       int    `mapstructure:"OC_EMAIL_MAX_ATTEMPTS"`
+	EmailRetryDelay        string `mapstructure:"OC_EMAIL_RETRY_DELAY"`
+	EmailWorkerInterval    string `mapstructure:"OC_EMAIL_WORKER_INTERVAL"`
 	PasswordCost           int    `mapstructure:"OC_PASSWORD_COST"`
+	PublicURL              string `mapstructure:"OC_PUBLIC_URL"`
 	RecaptchaSecretKey     string `mapstructure:"OC_RECAPTCHA_SECRET_KEY"`
 	RecaptchaSecretKeyFile string `mapstructure:"OC_RECAPTCHA_SECRET_KEY_FILE"`
-	ResendApiKey           string `mapstructure:"OC_RESEND_API_KEY"`
-	ResendApiKeyFile       string `mapstructure:"OC_RESEND_API_KEY_FILE"`
+	ReminderWorkerInterval string `mapstructure:"OC_REMINDER_WORKER_INTERVAL"`
+	SocialFontDirectory    string `mapstructure:"OC_SOCIAL_FONT_DIRECTORY"`
+	SocialImageDirectory   string `mapstructure:"OC_SOCIAL_IMAGE_DIRECTORY"`
+	Timezone               string `mapstructure:"OC_TIMEZONE"`
+	UnsubscribeSecret      string `mapstructure:"OC_UNSUBSCRIBE_SECRET"`
+	UnsubscribeSecretFile  string `mapstructure:"OC_UNSUBSCRIBE_SECRET_FILE"`
 }
 
 type DatabaseConfig struct {
@@ -72,8 +83,28 @@ type JwtConfig struct {
 	SecretFile string `mapstructure:"OC_JWT_SECRET_FILE"`
 }
 
+type MailerConfig struct {
+	FileDirectory     string `mapstructure:"OC_EMAIL_FILE_DIRECTORY"`
+	ResendApiKey      string `mapstructure:"OC_RESEND_API_KEY"`
+	ResendApiKeyFile  string `mapstructure:"OC_RESEND_API_KEY_FILE"`
+	SmtpHost          string `mapstructure:"OC_SMTP_HOST"`
+	SmtpPassword      string `mapstructure:"OC_SMTP_PASSWORD"`
+	SmtpPasswordFile  string `mapstructure:"OC_SMTP_PASSWORD_FILE"`
+	SmtpPort          string `mapstructure:"OC_SMTP_PORT"`
+	SmtpUsername      string `mapstructure:"OC_SMTP_USERNAME"`
+	Transport         string `mapstructure:"OC_EMAIL_TRANSPORT"`
+	WebhookSecret     string `mapstructure:"OC_EMAIL_WEBHOOK_SECRET"`
+	WebhookSecretFile string `mapstructure:"OC_EMAIL_WEBHOOK_SECRET_FILE"`
+}
+
 type StoreConfig struct {
-	EventsRSSURL string `mapstructure:"OC_EVENTS_RSS_URL"`
+	EventsCacheTTL       string `mapstructure:"OC_EVENTS_CACHE_TTL"`
+	EventsDateElement    string `mapstructure:"OC_EVENTS_DATE_ELEMENT"`
+	EventsDateLayout     string `mapstructure:"OC_EVENTS_DATE_LAYOUT"`
+	EventsDateSources    string `mapstructure:"OC_EVENTS_DATE_SOURCES"`
+	EventsRSSURL         string `mapstructure:"OC_EVENTS_RSS_URL"`
+	EventsTimezone       string `mapstructure:"OC_EVENTS_TIMEZONE"`
+	EventsURLDatePattern string `mapstructure:"OC_EVENTS_URL_DATE_PATTERN"`
 }
 
 type StorageConfig struct {
@@ -90,6 +121,7 @@ type Config struct {
 	App      AppConfig      `mapstructure:",squash"`
 	Database DatabaseConfig `mapstructure:",squash"`
 	Http     HttpConfig     `mapstructure:",squash"`
+	Mailer   MailerConfig   `mapstructure:",squash"`
 	Store    StoreConfig    `mapstructure:",squash"`
 	Storage  StorageConfig  `mapstructure:",squash"`
 }
@@ -118,10 +150,13 @@ func LoadConfig(env string) (Config, error) {
 	}
 
 	loadSecretFromFile(&config.App.RecaptchaSecretKey, config.App.RecaptchaSecretKeyFile, "Recaptcha Secret Key", env)
+	loadSecretFromFile(&config.App.UnsubscribeSecret, config.App.UnsubscribeSecretFile, "Unsubscribe Secret", env)
 	loadSecretFromFile(&config.Database.Password, config.Database.PasswordFile, "Database Password", env)
 	loadSecretFromFile(&config.Http.Jwt.Secret, config.Http.Jwt.SecretFile, "JWT Secret", env)
 	loadSecretFromFile(&config.Storage.SecretKey, config.Storage.SecretKeyFile, "Storage Secret Key", env)
-	loadSecretFromFile(&config.App.ResendApiKey, config.App.ResendApiKeyFile, "Resend API Key", env)
+	loadSecretFromFile(&config.Mailer.ResendApiKey, config.Mailer.ResendApiKeyFile, "Resend API Key", env)
+	loadSecretFromFile(&config.Mailer.SmtpPassword, config.Mailer.SmtpPasswordFile, "SMTP Password", env)
+	loadSecretFromFile(&config.Mailer.WebhookSecret, config.Mailer.WebhookSecretFile, "Email Webhook Secret", env)
 
 	return config, nil
 }
@@ -222,6 +257,27 @@ func (c *Config) Validate() error {
 		return errors.New("no domain for register provided")
 	}
 
+	// Leaving the transport unset without a Resend key turns email off, as
+	// it was before transports could be chosen.
+	switch c.Mailer.Transport {
+	case "":
+	case "resend":
+		if len(c.Mailer.ResendApiKey) == 0 {
+			return errors.New("no resend api key provided")
+		}
+	case "smtp":
+		if len(c.Mailer.SmtpHost) == 0 {
+			return errors.New("no smtp host provided")
+		}
+	case "file":
+		if len(c.Mailer.FileDirectory) == 0 {
+			return errors.New("no email file directory provided")
+		}
+	case "memory":
+	default:
+		return errors.New("unknown email transport provided")
+	}
+
 	if len(c.Storage.AccessKey) == 0 {
 		return errors.New("no storage access key provided")
 	}
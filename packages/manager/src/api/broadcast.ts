@@ -0,0 +1,24 @@
+import type {
+  Broadcast,
+  BroadcastPreview,
+  BroadcastRequest,
+  CreateBroadcastResponse,
+  GetBroadcastsResponse,
+} from '@/types/broadcast'
+import { apiFetch } from './client'
+
+export async function createBroadcast(token: string, request: BroadcastRequest): Promise<CreateBroadcastResponse> {
+  return apiFetch<CreateBroadcastResponse>(token, 'POST', '/api/v1/broadcast', request)
+}
+
+export async function fetchBroadcast(token: string, id: number): Promise<Broadcast> {
+  return apiFetch<Broadcast>(token, 'GET', `/api/v1/broadcast/${id}`)
+}
+
+export async function fetchBroadcasts(token: string): Promise<GetBroadcastsResponse> {
+  return apiFetch<GetBroadcastsResponse>(token, 'GET', '/api/v1/broadcast')
+}
+
+export async function previewBroadcast(token: string, request: BroadcastRequest): Promise<BroadcastPreview> {
+  return apiFetch<BroadcastPreview>(token, 'POST', '/api/v1/broadcast/preview', request)
+}
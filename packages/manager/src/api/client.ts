@@ -36,3 +36,35 @@ export async function apiFetch<T>(
     throw new Error('An error occurred. Please try again.')
   }
 }
+
+export async function apiFetchBlob(
+  token: string,
+  method: 'GET' | 'POST',
+  url: string,
+  body?: unknown,
+): Promise<{ blob: Blob; fileName: string }> {
+  let response: Response
+  try {
+    response = await fetch(url, {
+      method,
+      headers: {
+        Authorization: `Bearer ${token}`,
+        'Content-Type': 'application/json',
+      },
+      ...(body !== undefined ? { body: JSON.stringify(body) } : {}),
+    })
+  } catch {
+    throw new Error('An error occurred. Please try again.')
+  }
+
+  if (response.status === 401) {
+    throw new UnauthorizedError()
+  } else if (response.status >= 300) {
+    throw new Error('An error occurred. Please try again.')
+  }
+
+  const disposition = response.headers.get('Content-Disposition') || ''
+  const fileName = /filename="?([^";]+)"?/.exec(disposition)?.[1] || 'download'
+
+  return { blob: await response.blob(), fileName }
+}
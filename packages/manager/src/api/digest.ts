@@ -0,0 +1,13 @@
+import type { DigestPreference, GetDigestPreferenceResponse, UpdateDigestPreferenceResponse } from '@/types/digest'
+import { apiFetch } from './client'
+
+export async function fetchDigestPreference(token: string): Promise<GetDigestPreferenceResponse> {
+  return apiFetch<GetDigestPreferenceResponse>(token, 'GET', '/api/v1/digest')
+}
+
+export async function updateDigestPreference(
+  token: string,
+  preference: DigestPreference,
+): Promise<UpdateDigestPreferenceResponse> {
+  return apiFetch<UpdateDigestPreferenceResponse>(token, 'PUT', '/api/v1/digest', preference)
+}
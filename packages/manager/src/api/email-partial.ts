@@ -0,0 +1,24 @@
+import type {
+  CreateEmailPartialResponse,
+  EmailPartial,
+  GetEmailPartialsResponse,
+  UpdateEmailPartialResponse,
+} from '@/types/email-partial'
+import { apiFetch } from './client'
+
+export async function createEmailPartial(token: string, partial: EmailPartial): Promise<CreateEmailPartialResponse> {
+  return apiFetch<CreateEmailPartialResponse>(token, 'POST', '/api/v1/partial', partial)
+}
+
+export async function fetchEmailPartials(token: string): Promise<GetEmailPartialsResponse> {
+  return apiFetch<GetEmailPartialsResponse>(token, 'GET', '/api/v1/partial')
+}
+
+export async function removeEmailPartial(token: string, id: number): Promise<boolean> {
+  await apiFetch(token, 'DELETE', `/api/v1/partial/${id}`)
+  return true
+}
+
+export async function updateEmailPartial(token: string, partial: EmailPartial): Promise<UpdateEmailPartialResponse> {
+  return apiFetch<UpdateEmailPartialResponse>(token, 'PUT', `/api/v1/partial/${partial.id}`, partial)
+}
@@ -0,0 +1,15 @@
+import type { CreateEmailSuppressionResponse, GetEmailSuppressionsResponse } from '@/types/email-suppression'
+import { apiFetch } from './client'
+
+export async function createEmailSuppression(token: string, address: string): Promise<CreateEmailSuppressionResponse> {
+  return apiFetch<CreateEmailSuppressionResponse>(token, 'POST', '/api/v1/suppression', { address })
+}
+
+export async function fetchEmailSuppressions(token: string): Promise<GetEmailSuppressionsResponse> {
+  return apiFetch<GetEmailSuppressionsResponse>(token, 'GET', '/api/v1/suppression')
+}
+
+export async function removeEmailSuppression(token: string, id: number): Promise<boolean> {
+  await apiFetch(token, 'DELETE', `/api/v1/suppression/${id}`)
+  return true
+}
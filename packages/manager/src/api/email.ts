@@ -1,19 +1,68 @@
-import type { CreateEmailResponse, Email, GetEmailsResponse, UpdateEmailResponse } from '@/types/email'
+import type {
+  CreateEmailResponse,
+  DiffEmailRevisionsResponse,
+  Email,
+  EmailPreviewRequest,
+  GetEmailRevisionResponse,
+  GetEmailRevisionsResponse,
+  GetEmailsResponse,
+  GetEmailUsageResponse,
+  PreviewEmailResponse,
+  RestoreEmailRevisionResponse,
+  UpdateEmailResponse,
+} from '@/types/email'
 import { apiFetch } from './client'
 
 export async function createEmail(token: string, email: Email): Promise<CreateEmailResponse> {
   return apiFetch<CreateEmailResponse>(token, 'POST', '/api/v1/email', email)
 }
 
+export async function diffEmailRevisions(
+  token: string,
+  id: number,
+  from: number,
+  to: number,
+): Promise<DiffEmailRevisionsResponse> {
+  return apiFetch<DiffEmailRevisionsResponse>(token, 'GET', `/api/v1/email/${id}/diff?from=${from}&to=${to}`)
+}
+
+export async function fetchEmailRevision(token: string, id: number, revision: number): Promise<GetEmailRevisionResponse> {
+  return apiFetch<GetEmailRevisionResponse>(token, 'GET', `/api/v1/email/${id}/revision/${revision}`)
+}
+
+export async function fetchEmailRevisions(token: string, id: number): Promise<GetEmailRevisionsResponse> {
+  return apiFetch<GetEmailRevisionsResponse>(token, 'GET', `/api/v1/email/${id}/revision`)
+}
+
 export async function fetchEmails(token: string): Promise<GetEmailsResponse> {
   return apiFetch<GetEmailsResponse>(token, 'GET', '/api/v1/email')
 }
 
+export async function fetchEmailUsage(token: string, id: number): Promise<GetEmailUsageResponse> {
+  return apiFetch<GetEmailUsageResponse>(token, 'GET', `/api/v1/email/${id}/used-by`)
+}
+
+export async function previewEmail(
+  token: string,
+  id: number,
+  request: EmailPreviewRequest,
+): Promise<PreviewEmailResponse> {
+  return apiFetch<PreviewEmailResponse>(token, 'POST', `/api/v1/email/${id}/preview`, request)
+}
+
 export async function removeEmail(token: string, id: number): Promise<boolean> {
   await apiFetch(token, 'DELETE', `/api/v1/email/${id}`)
   return true
 }
 
+export async function restoreEmailRevision(
+  token: string,
+  id: number,
+  revision: number,
+): Promise<RestoreEmailRevisionResponse> {
+  return apiFetch<RestoreEmailRevisionResponse>(token, 'POST', `/api/v1/email/${id}/revision/${revision}/restore`)
+}
+
 export async function updateEmail(token: string, email: Email): Promise<UpdateEmailResponse> {
   return apiFetch<UpdateEmailResponse>(token, 'PUT', `/api/v1/email/${email.id}`, email)
 }
@@ -0,0 +1,48 @@
+import type {
+  CreateEventResponse,
+  Event,
+  EventLocationOverride,
+  GetEventLocationOverridesResponse,
+  GetEventResponse,
+  GetEventsResponse,
+  SetEventLocationOverrideResponse,
+  UpdateEventResponse,
+} from '@/types/event'
+import { apiFetch } from './client'
+
+export async function createEvent(token: string, event: Event): Promise<CreateEventResponse> {
+  return apiFetch<CreateEventResponse>(token, 'POST', '/api/v1/event', event)
+}
+
+export async function fetchEvent(token: string, id: number): Promise<GetEventResponse> {
+  return apiFetch<GetEventResponse>(token, 'GET', `/api/v1/event/${id}`)
+}
+
+export async function fetchEventLocationOverrides(token: string): Promise<GetEventLocationOverridesResponse> {
+  return apiFetch<GetEventLocationOverridesResponse>(token, 'GET', '/api/v1/event/location-override')
+}
+
+export async function fetchEvents(token: string): Promise<GetEventsResponse> {
+  return apiFetch<GetEventsResponse>(token, 'GET', '/api/v1/event')
+}
+
+export async function removeEvent(token: string, id: number): Promise<boolean> {
+  await apiFetch(token, 'DELETE', `/api/v1/event/${id}`)
+  return true
+}
+
+export async function removeEventLocationOverride(token: string, eventKey: string): Promise<boolean> {
+  await apiFetch(token, 'DELETE', `/api/v1/event/location-override?eventKey=${encodeURIComponent(eventKey)}`)
+  return true
+}
+
+export async function setEventLocationOverride(
+  token: string,
+  override: EventLocationOverride,
+): Promise<SetEventLocationOverrideResponse> {
+  return apiFetch<SetEventLocationOverrideResponse>(token, 'PUT', '/api/v1/event/location-override', override)
+}
+
+export async function updateEvent(token: string, event: Event): Promise<UpdateEventResponse> {
+  return apiFetch<UpdateEventResponse>(token, 'PUT', `/api/v1/event/${event.id}`, event)
+}
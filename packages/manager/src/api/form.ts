@@ -5,6 +5,7 @@ import type {
   GetSubmissionsResponse,
   UpdateFormResponse,
 } from '@/types/form'
+import type { GetOutboundEmailsResponse } from '@/types/outbound-email'
 import { apiFetch } from './client'
 
 export async function createForm(token: string, form: Form): Promise<CreateFormResponse> {
@@ -32,7 +33,15 @@ export async function fetchSubmissions(token: string, formId: number): Promise<G
   return apiFetch<GetSubmissionsResponse>(token, 'GET', `/api/v1/submission?formId=${formId}`)
 }
 
+export async function searchSubmissions(token: string, reference: string): Promise<GetSubmissionsResponse> {
+  return apiFetch<GetSubmissionsResponse>(token, 'GET', `/api/v1/submission?reference=${encodeURIComponent(reference)}`)
+}
+
 export async function removeSubmission(token: string, id: number): Promise<boolean> {
   await apiFetch(token, 'DELETE', `/api/v1/submission/${id}`)
   return true
 }
+
+export async function fetchSubmissionEmails(token: string, id: number): Promise<GetOutboundEmailsResponse> {
+  return apiFetch<GetOutboundEmailsResponse>(token, 'GET', `/api/v1/submission/${id}/emails`)
+}
@@ -0,0 +1,28 @@
+import type { GetOutboundEmailsResponse, OutboundEmail, OutboundEmailStatus } from '@/types/outbound-email'
+import { apiFetch } from './client'
+
+export interface OutboundEmailFilter {
+  status?: OutboundEmailStatus
+  formId?: number
+  submissionId?: number
+  recipient?: string
+}
+
+export async function fetchOutboundEmails(
+  token: string,
+  filter: OutboundEmailFilter = {},
+): Promise<GetOutboundEmailsResponse> {
+  const params = new URLSearchParams()
+  for (const [key, value] of Object.entries(filter)) {
+    if (value !== undefined && value !== '') {
+      params.set(key, String(value))
+    }
+  }
+
+  const query = params.toString()
+  return apiFetch<GetOutboundEmailsResponse>(token, 'GET', `/api/v1/outbox${query ? `?${query}` : ''}`)
+}
+
+export async function retryOutboundEmail(token: string, id: number): Promise<OutboundEmail> {
+  return apiFetch<OutboundEmail>(token, 'POST', `/api/v1/outbox/${id}/retry`)
+}
@@ -0,0 +1,28 @@
+import type {
+  MonthlySocialImageRequest,
+  QtbipocSocialImageRequest,
+  SaveSocialImagesResponse,
+} from '@/types/social-image'
+import { apiFetch, apiFetchBlob } from './client'
+
+export async function renderMonthlySocialImages(token: string, request: MonthlySocialImageRequest) {
+  return apiFetchBlob(token, 'POST', '/api/v1/social/monthly', { ...request, save: false })
+}
+
+export async function renderQtbipocSocialImage(token: string, request: QtbipocSocialImageRequest) {
+  return apiFetchBlob(token, 'POST', '/api/v1/social/qtbipoc', { ...request, save: false })
+}
+
+export async function saveMonthlySocialImages(
+  token: string,
+  request: MonthlySocialImageRequest,
+): Promise<SaveSocialImagesResponse> {
+  return apiFetch<SaveSocialImagesResponse>(token, 'POST', '/api/v1/social/monthly', { ...request, save: true })
+}
+
+export async function saveQtbipocSocialImage(
+  token: string,
+  request: QtbipocSocialImageRequest,
+): Promise<SaveSocialImagesResponse> {
+  return apiFetch<SaveSocialImagesResponse>(token, 'POST', '/api/v1/social/qtbipoc', { ...request, save: true })
+}
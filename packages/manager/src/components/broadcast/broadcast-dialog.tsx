@@ -0,0 +1,255 @@
+'use client'
+
+import type { BroadcastFilter, BroadcastRequest } from '@/types/broadcast'
+import { Button } from '@/components/ui/button'
+import { createBroadcast, previewBroadcast } from '@/api/broadcast'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import type { Email } from '@/types/email'
+import { Field, FieldError, FieldLabel } from '../ui/field'
+import type { Form } from '@/types/form'
+import { Input } from '@/components/ui/input'
+import { Plus, X } from 'lucide-react'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCallback, useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useBroadcastStore from '@/stores/broadcast'
+import useSelfStore from '@/stores/self'
+
+interface FormData {
+  emailId: string
+  formId: string
+  filters: Array<BroadcastFilter>
+}
+
+const emptyFormData: FormData = {
+  emailId: '',
+  formId: '',
+  filters: [],
+}
+
+interface BroadcastDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+  emails: Array<Email>
+  forms: Array<Form>
+}
+
+export function BroadcastDialog({ open, onOpenChange, emails, forms }: BroadcastDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+  const { populateSingle } = useBroadcastStore()
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [formError, setFormError] = useState<string>('')
+  const [formData, setFormData] = useState<FormData>(emptyFormData)
+  const [recipientCount, setRecipientCount] = useState<number | null>(null)
+
+  if (!open && (formData !== emptyFormData || formError !== '' || recipientCount !== null)) {
+    setFormData(emptyFormData)
+    setFormError('')
+    setRecipientCount(null)
+  }
+
+  const selectedForm = forms.find((form) => String(form.id) === formData.formId)
+
+  // Any change means the recipient count shown no longer applies.
+  const updateFormData = useCallback((update: (prev: FormData) => FormData) => {
+    setFormData(update)
+    setRecipientCount(null)
+  }, [])
+
+  const handleFilterChange = (index: number, key: keyof BroadcastFilter) => {
+    return (value: string) => {
+      updateFormData((prev) => ({
+        ...prev,
+        filters: prev.filters.map((filter, i) => (i === index ? { ...filter, [key]: value } : filter)),
+      }))
+    }
+  }
+
+  const handleAddFilter = () => {
+    updateFormData((prev) => ({ ...prev, filters: [...prev.filters, { fieldSlug: '', value: '' }] }))
+  }
+
+  const handleRemoveFilter = (index: number) => {
+    return () => {
+      updateFormData((prev) => ({ ...prev, filters: prev.filters.filter((_, i) => i !== index) }))
+    }
+  }
+
+  const handleCancel = useCallback(() => {
+    onOpenChange(false)
+  }, [onOpenChange])
+
+  const buildRequest = (): BroadcastRequest | null => {
+    if (!formData.emailId || !formData.formId) {
+      setFormError('Please choose an email and a form')
+      return null
+    }
+    setFormError('')
+
+    return {
+      emailId: Number(formData.emailId),
+      formId: Number(formData.formId),
+      filters: formData.filters.filter((filter) => filter.fieldSlug !== ''),
+    }
+  }
+
+  const handlePreview = async () => {
+    const request = buildRequest()
+    if (request == null) {
+      return
+    }
+
+    setIsLoading(true)
+    try {
+      const preview = await previewBroadcast(token || '', request)
+      setRecipientCount(preview.recipientCount)
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      } else {
+        setFormError('Unable to preview this broadcast, check the email renders for this form')
+      }
+    }
+    setIsLoading(false)
+  }
+
+  const handleSend = async () => {
+    const request = buildRequest()
+    if (request == null) {
+      return
+    }
+
+    setIsLoading(true)
+    try {
+      const broadcast = await createBroadcast(token || '', request)
+      populateSingle(broadcast)
+      onOpenChange(false)
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      } else {
+        setFormError('Unable to send this broadcast')
+      }
+    }
+    setIsLoading(false)
+  }
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>Send Broadcast</DialogTitle>
+        </DialogHeader>
+
+        <div className="no-scrollbar -mx-4 max-h-[75vh] overflow-y-auto px-4">
+          <form onSubmit={() => false}>
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="emailId">Email</FieldLabel>
+                <Select
+                  value={formData.emailId}
+                  onValueChange={(value) => updateFormData((prev) => ({ ...prev, emailId: value }))}
+                  disabled={isLoading}>
+                  <SelectTrigger id="emailId" className="w-full">
+                    <SelectValue placeholder="Choose an email" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {emails.map((email) => (
+                      <SelectItem key={email.id} value={String(email.id)}>
+                        {email.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="formId">Registrants Of</FieldLabel>
+                <Select
+                  value={formData.formId}
+                  onValueChange={(value) => updateFormData((prev) => ({ ...prev, formId: value, filters: [] }))}
+                  disabled={isLoading}>
+                  <SelectTrigger id="formId" className="w-full">
+                    <SelectValue placeholder="Choose a form" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {forms.map((form) => (
+                      <SelectItem key={form.id} value={String(form.id)}>
+                        {form.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+
+            {selectedForm && (
+              <Field>
+                <FieldLabel>Only Registrants Where</FieldLabel>
+                {formData.filters.map((filter, index) => (
+                  <div key={index} className="flex gap-2">
+                    <Select value={filter.fieldSlug} onValueChange={handleFilterChange(index, 'fieldSlug')}>
+                      <SelectTrigger className="w-1/2">
+                        <SelectValue placeholder="Field" />
+                      </SelectTrigger>
+                      <SelectContent>
+                        {selectedForm.fields.map((field) => (
+                          <SelectItem key={field.id} value={field.slug}>
+                            {field.name}
+                          </SelectItem>
+                        ))}
+                      </SelectContent>
+                    </Select>
+                    <Input
+                      placeholder="Value"
+                      value={filter.value}
+                      onChange={(e) => handleFilterChange(index, 'value')(e.target.value)}
+                      disabled={isLoading}
+                    />
+                    <Button variant="ghost" size="icon" type="button" onClick={handleRemoveFilter(index)}>
+                      <X />
+                    </Button>
+                  </div>
+                ))}
+                <div>
+                  <Button variant="secondary" type="button" onClick={handleAddFilter} disabled={isLoading}>
+                    <Plus />
+                    Add Filter
+                  </Button>
+                </div>
+              </Field>
+            )}
+
+            <FieldError>{formError}</FieldError>
+
+            {recipientCount !== null && (
+              <p className="mt-4 text-sm">
+                This will go to <strong>{recipientCount}</strong> {recipientCount === 1 ? 'registrant' : 'registrants'}.
+              </p>
+            )}
+          </form>
+        </div>
+
+        <DialogFooter>
+          <Button disabled={isLoading} variant="secondary" type="button" onClick={handleCancel}>
+            Cancel
+          </Button>
+          {recipientCount === null ? (
+            <Button disabled={isLoading} variant="default" type="button" onClick={handlePreview}>
+              Preview
+            </Button>
+          ) : (
+            <Button disabled={isLoading || recipientCount === 0} variant="default" type="button" onClick={handleSend}>
+              Send
+            </Button>
+          )}
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
@@ -0,0 +1,51 @@
+'use client'
+
+import type { Broadcast } from '@/types/broadcast'
+import { format } from 'date-fns'
+import type { Form } from '@/types/form'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+
+export function BroadcastsTable({ data, forms }: { data: Array<Broadcast>; forms: Record<number, Form> }) {
+  return (
+    <div className="overflow-x-auto">
+      <Table>
+        <TableHeader>
+          <TableRow>
+            <TableHead>Sent On</TableHead>
+            <TableHead>Email</TableHead>
+            <TableHead>Form</TableHead>
+            <TableHead>Filters</TableHead>
+            <TableHead>Recipients</TableHead>
+            <TableHead>Pending</TableHead>
+            <TableHead>Sent</TableHead>
+            <TableHead>Failed</TableHead>
+            <TableHead>Suppressed</TableHead>
+          </TableRow>
+        </TableHeader>
+        <TableBody>
+          {data
+            .sort((a, b) => b.createdAt - a.createdAt)
+            .map((item) => (
+              <TableRow key={item.id}>
+                <TableCell>
+                  {format(item.createdAt, "MMMM d, yyyy 'at' h:mm aa")} by {item.createdBy}
+                </TableCell>
+                <TableCell>{item.emailSlug}</TableCell>
+                <TableCell>{forms[item.formId]?.name || '-'}</TableCell>
+                <TableCell>
+                  {item.filters.length === 0
+                    ? 'Everyone'
+                    : item.filters.map((filter) => `${filter.fieldSlug} = ${filter.value}`).join(', ')}
+                </TableCell>
+                <TableCell>{item.recipientCount}</TableCell>
+                <TableCell>{item.pending}</TableCell>
+                <TableCell>{item.sent}</TableCell>
+                <TableCell>{item.failed}</TableCell>
+                <TableCell>{item.suppressed}</TableCell>
+              </TableRow>
+            ))}
+        </TableBody>
+      </Table>
+    </div>
+  )
+}
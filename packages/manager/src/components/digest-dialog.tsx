@@ -0,0 +1,171 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import type { DigestPreference } from '@/types/digest'
+import { fetchDigestPreference, updateDigestPreference } from '@/api/digest'
+import { Field, FieldDescription, FieldError, FieldLabel } from './ui/field'
+import { format } from 'date-fns'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { Spinner } from '@/components/ui/spinner'
+import { Switch } from '@/components/ui/switch'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useEffect, useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useSelfStore from '@/stores/self'
+
+const WEEKDAYS = ['Sunday', 'Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday']
+
+const HOURS = Array.from({ length: 24 }, (_, hour) => hour)
+
+interface DigestDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+}
+
+export function DigestDialog({ open, onOpenChange }: DigestDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [formError, setFormError] = useState<string>('')
+  const [preference, setPreference] = useState<DigestPreference | null>(null)
+
+  if (!open && (preference !== null || formError !== '')) {
+    setPreference(null)
+    setFormError('')
+  }
+
+  useEffect(() => {
+    if (!open || preference !== null) return
+
+    const load = async () => {
+      setIsLoading(true)
+      try {
+        setPreference(await fetchDigestPreference(token || ''))
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          setFormError('Unable to load your digest settings')
+        }
+      } finally {
+        setIsLoading(false)
+      }
+    }
+
+    load()
+  }, [open, preference, token, navigate])
+
+  const handleCancel = () => {
+    onOpenChange(false)
+  }
+
+  const handleSubmit = async () => {
+    if (preference == null) {
+      return
+    }
+
+    setIsLoading(true)
+    try {
+      await updateDigestPreference(token || '', preference)
+      onOpenChange(false)
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      } else {
+        setFormError('Unable to save your digest settings')
+      }
+    }
+    setIsLoading(false)
+  }
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>Weekly Digest</DialogTitle>
+        </DialogHeader>
+
+        {preference === null && !formError && (
+          <div className="flex justify-center py-4">
+            <Spinner />
+          </div>
+        )}
+
+        {preference !== null && (
+          <form onSubmit={() => false}>
+            <div className="mb-4">
+              <Field orientation="horizontal">
+                <FieldLabel htmlFor="enabled">Email me a weekly digest</FieldLabel>
+                <Switch
+                  id="enabled"
+                  checked={preference.enabled}
+                  disabled={isLoading}
+                  onCheckedChange={(checked) => setPreference((prev) => prev && { ...prev, enabled: checked })}
+                />
+              </Field>
+              <FieldDescription>
+                New registrations and fill levels for your forms, plus forms and redirects changing this week.
+                {preference.lastSentAt
+                  ? ` Last sent ${format(preference.lastSentAt, "MMMM d, yyyy 'at' h:mm aa")}.`
+                  : ''}
+              </FieldDescription>
+            </div>
+
+            <div className="mb-4 flex gap-2">
+              <Field>
+                <FieldLabel htmlFor="weekday">Day</FieldLabel>
+                <Select
+                  value={String(preference.weekday)}
+                  onValueChange={(value) => setPreference((prev) => prev && { ...prev, weekday: Number(value) })}
+                  disabled={isLoading || !preference.enabled}>
+                  <SelectTrigger id="weekday" className="w-full">
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {WEEKDAYS.map((weekday, index) => (
+                      <SelectItem key={index} value={String(index)}>
+                        {weekday}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+
+              <Field>
+                <FieldLabel htmlFor="hour">Time</FieldLabel>
+                <Select
+                  value={String(preference.hour)}
+                  onValueChange={(value) => setPreference((prev) => prev && { ...prev, hour: Number(value) })}
+                  disabled={isLoading || !preference.enabled}>
+                  <SelectTrigger id="hour" className="w-full">
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {HOURS.map((hour) => (
+                      <SelectItem key={hour} value={String(hour)}>
+                        {format(new Date(2000, 0, 1, hour), 'h aa')}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+          </form>
+        )}
+
+        <FieldError>{formError}</FieldError>
+
+        <DialogFooter>
+          <Button disabled={isLoading} variant="secondary" type="button" onClick={handleCancel}>
+            Cancel
+          </Button>
+          <Button disabled={isLoading || preference === null} variant="default" type="button" onClick={handleSubmit}>
+            Save
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
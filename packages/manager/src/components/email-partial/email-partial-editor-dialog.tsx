@@ -0,0 +1,207 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import { createEmailPartial, updateEmailPartial } from '@/api/email-partial'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import type { EmailPartial } from '@/types/email-partial'
+import { Field, FieldDescription, FieldError, FieldLabel } from '../ui/field'
+import { Input } from '@/components/ui/input'
+import { Switch } from '@/components/ui/switch'
+import { Textarea } from '../ui/textarea'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCallback, useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useEmailPartialStore from '@/stores/email-partial'
+import useSelfStore from '@/stores/self'
+
+const emptyFormData: EmailPartial = {
+  id: 0,
+  name: '',
+  slug: '',
+  layout: false,
+  htmlBody: '',
+  textBody: '',
+}
+
+const emptyFormError = {
+  name: '',
+  slug: '',
+  submit: '',
+}
+
+interface EmailPartialEditorDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+  initialPartial?: EmailPartial
+}
+
+export function EmailPartialEditorDialog({ open, onOpenChange, initialPartial }: EmailPartialEditorDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+  const { populateSingle } = useEmailPartialStore()
+
+  const isEditing = initialPartial !== undefined
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [formError, setFormError] = useState(emptyFormError)
+  const [formData, setFormData] = useState<EmailPartial>(emptyFormData)
+
+  if (!open && formData !== emptyFormData) {
+    setFormData(emptyFormData)
+    setFormError(emptyFormError)
+  }
+
+  if (open && formData.id === 0 && initialPartial != null) {
+    setFormData(initialPartial)
+  }
+
+  const handleChange = useCallback((e: React.ChangeEvent<HTMLInputElement | HTMLTextAreaElement>) => {
+    const { name, value } = e.target
+    setFormData((prev) => ({ ...prev, [name]: value }))
+  }, [])
+
+  const handleCancel = useCallback(() => {
+    onOpenChange(false)
+  }, [onOpenChange])
+
+  const handleSubmit = useCallback(
+    async (e: React.MouseEvent<HTMLButtonElement>) => {
+      e.preventDefault()
+      let hasError = false
+      const nextError = { ...emptyFormError }
+
+      if (!formData.name.trim()) {
+        hasError = true
+        nextError.name = 'Please fill in this field'
+      }
+
+      if (!formData.slug.trim()) {
+        hasError = true
+        nextError.slug = 'Please fill in this field'
+      }
+
+      setFormError(nextError)
+
+      if (!hasError) {
+        setIsLoading(true)
+        try {
+          const payload = { ...formData, name: formData.name.trim(), slug: formData.slug.trim() }
+          const partial = isEditing
+            ? await updateEmailPartial(token || '', payload)
+            : await createEmailPartial(token || '', payload)
+          populateSingle(partial)
+          onOpenChange(false)
+        } catch (error) {
+          if (error instanceof UnauthorizedError) {
+            navigate({ to: '/manage/login' })
+          } else {
+            setFormError({ ...nextError, submit: 'Unable to save, check the templates parse and the slug is unused' })
+          }
+        }
+        setIsLoading(false)
+      }
+    },
+    [formData, isEditing, populateSingle, onOpenChange, token, navigate],
+  )
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="sm:max-w-2xl">
+        <DialogHeader>
+          <DialogTitle>{isEditing ? 'Edit Partial' : 'Create Partial'}</DialogTitle>
+        </DialogHeader>
+
+        <div className="no-scrollbar -mx-4 max-h-[75vh] overflow-y-auto px-4">
+          <form onSubmit={() => false}>
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="name">Name</FieldLabel>
+                <Input
+                  id="name"
+                  name="name"
+                  type="text"
+                  value={formData.name}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                  required
+                />
+                <FieldError>{formError.name}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="slug">Slug</FieldLabel>
+                <Input
+                  id="slug"
+                  name="slug"
+                  type="text"
+                  value={formData.slug}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                  required
+                />
+                <FieldError>{formError.slug}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field orientation="horizontal">
+                <FieldLabel htmlFor="layout">Layout</FieldLabel>
+                <Switch
+                  id="layout"
+                  checked={formData.layout}
+                  disabled={isLoading}
+                  onCheckedChange={(checked) => setFormData((prev) => ({ ...prev, layout: checked }))}
+                />
+              </Field>
+              <FieldDescription>
+                {formData.layout
+                  ? 'Layouts wrap an email and must include {{template "content" .}} where it goes.'
+                  : `Include this partial in an email with {{template "${formData.slug || 'slug'}" .}}.`}
+              </FieldDescription>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="htmlBody">HTML Body</FieldLabel>
+                <Textarea
+                  id="htmlBody"
+                  name="htmlBody"
+                  className="font-mono"
+                  value={formData.htmlBody}
+                  rows={8}
+                  disabled={isLoading}
+                  onChange={handleChange}
+                />
+              </Field>
+            </div>
+
+            <Field>
+              <FieldLabel htmlFor="textBody">Text Body</FieldLabel>
+              <Textarea
+                id="textBody"
+                name="textBody"
+                className="font-mono"
+                value={formData.textBody}
+                rows={6}
+                disabled={isLoading}
+                onChange={handleChange}
+              />
+              <FieldError>{formError.submit}</FieldError>
+            </Field>
+          </form>
+        </div>
+
+        <DialogFooter>
+          <Button disabled={isLoading} variant="secondary" type="button" onClick={handleCancel}>
+            Cancel
+          </Button>
+          <Button disabled={isLoading} variant="default" type="button" onClick={handleSubmit}>
+            {isEditing ? 'Save' : 'Create'}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
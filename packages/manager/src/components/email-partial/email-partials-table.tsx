@@ -0,0 +1,65 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import type { EmailPartial } from '@/types/email-partial'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+
+export function EmailPartialsTable({
+  data,
+  canEdit,
+  onEdit,
+  onDelete,
+}: {
+  data: Array<EmailPartial>
+  canEdit: boolean
+  onEdit: (id: number) => void
+  onDelete: (id: number) => void
+}) {
+  const handleEdit = (id: number) => {
+    return () => {
+      onEdit(id)
+    }
+  }
+
+  const handleDelete = (id: number) => {
+    return () => {
+      onDelete(id)
+    }
+  }
+
+  return (
+    <div className="overflow-x-auto">
+      <Table>
+        <TableHeader>
+          <TableRow>
+            <TableHead>Name</TableHead>
+            <TableHead>Slug</TableHead>
+            <TableHead>Used As</TableHead>
+            {canEdit && <TableHead className="text-right">Actions</TableHead>}
+          </TableRow>
+        </TableHeader>
+        <TableBody>
+          {data.map((item) => (
+            <TableRow key={item.id}>
+              <TableCell>{item.name}</TableCell>
+              <TableCell className="font-mono">{item.layout ? item.slug : `{{template "${item.slug}" .}}`}</TableCell>
+              <TableCell>{item.layout ? 'Layout' : 'Partial'}</TableCell>
+              {canEdit && (
+                <TableCell>
+                  <div className="flex justify-end gap-2">
+                    <Button variant="secondary" onClick={handleEdit(item.id)}>
+                      Edit
+                    </Button>
+                    <Button variant="destructive" onClick={handleDelete(item.id)}>
+                      Delete
+                    </Button>
+                  </div>
+                </TableCell>
+              )}
+            </TableRow>
+          ))}
+        </TableBody>
+      </Table>
+    </div>
+  )
+}
@@ -0,0 +1,164 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import type { EmailPreview } from '@/types/email'
+import { Field, FieldDescription, FieldError, FieldLabel } from '../ui/field'
+import type { Form } from '@/types/form'
+import { Input } from '@/components/ui/input'
+import { previewEmail } from '@/api/email'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useSelfStore from '@/stores/self'
+
+interface EmailPreviewDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+  emailId: number
+  forms: Array<Form>
+}
+
+export function EmailPreviewDialog({ open, onOpenChange, emailId, forms }: EmailPreviewDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [formError, setFormError] = useState<string>('')
+  const [formId, setFormId] = useState<string>('')
+  const [submissionId, setSubmissionId] = useState<string>('')
+  const [preview, setPreview] = useState<EmailPreview | null>(null)
+
+  if (!open && (formId !== '' || submissionId !== '' || formError !== '' || preview !== null)) {
+    setFormId('')
+    setSubmissionId('')
+    setFormError('')
+    setPreview(null)
+  }
+
+  const handlePreview = (sendTest: boolean) => {
+    return async () => {
+      if (!formId) {
+        setFormError('Please choose a form')
+        return
+      }
+      setFormError('')
+
+      setIsLoading(true)
+      try {
+        setPreview(
+          await previewEmail(token || '', emailId, {
+            formId: Number(formId),
+            submissionId: submissionId ? Number(submissionId) : undefined,
+            sendTest,
+          }),
+        )
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          setFormError('Unable to preview this email, check the submission belongs to the form')
+        }
+      }
+      setIsLoading(false)
+    }
+  }
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="sm:max-w-3xl">
+        <DialogHeader>
+          <DialogTitle>Preview Email</DialogTitle>
+        </DialogHeader>
+
+        <div className="no-scrollbar -mx-4 max-h-[75vh] overflow-y-auto px-4">
+          <form onSubmit={() => false}>
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="formId">Form</FieldLabel>
+                <Select
+                  value={formId}
+                  onValueChange={(value) => {
+                    setFormId(value)
+                    setPreview(null)
+                  }}
+                  disabled={isLoading}>
+                  <SelectTrigger id="formId" className="w-full">
+                    <SelectValue placeholder="Choose a form" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {forms.map((form) => (
+                      <SelectItem key={form.id} value={String(form.id)}>
+                        {form.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="submissionId">Submission ID</FieldLabel>
+                <Input
+                  id="submissionId"
+                  name="submissionId"
+                  type="number"
+                  min={1}
+                  value={submissionId}
+                  onChange={(e) => setSubmissionId(e.target.value)}
+                  disabled={isLoading}
+                />
+                <FieldDescription>Leave empty to fill the form fields with sample values.</FieldDescription>
+              </Field>
+            </div>
+
+            <FieldError>{formError}</FieldError>
+          </form>
+
+          {preview && (
+            <div className="flex flex-col gap-4">
+              {preview.testQueued && <p className="text-sm">A test copy has been queued to your email address.</p>}
+
+              {preview.errors.length > 0 && (
+                <ul className="text-destructive list-disc pl-5 text-sm">
+                  {preview.errors.map((problem, index) => (
+                    <li key={index}>
+                      {problem.field}
+                      {problem.line ? ` line ${problem.line}` : ''}: {problem.message}
+                    </li>
+                  ))}
+                </ul>
+              )}
+
+              <Field>
+                <FieldLabel>Subject</FieldLabel>
+                <p className="text-sm">{preview.subject}</p>
+              </Field>
+
+              <Field>
+                <FieldLabel>HTML Body</FieldLabel>
+                <iframe title="HTML Body" sandbox="" srcDoc={preview.htmlBody} className="h-96 w-full rounded-md border" />
+              </Field>
+
+              <Field>
+                <FieldLabel>Text Body</FieldLabel>
+                <pre className="overflow-x-auto rounded-md border p-2 text-sm whitespace-pre-wrap">{preview.textBody}</pre>
+              </Field>
+            </div>
+          )}
+        </div>
+
+        <DialogFooter>
+          <Button disabled={isLoading} variant="secondary" type="button" onClick={handlePreview(true)}>
+            Send Test To Me
+          </Button>
+          <Button disabled={isLoading} variant="default" type="button" onClick={handlePreview(false)}>
+            Preview
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
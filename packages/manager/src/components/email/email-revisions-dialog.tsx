@@ -0,0 +1,222 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import { cn } from '@/lib/utils'
+import { diffEmailRevisions, fetchEmailRevisions, restoreEmailRevision } from '@/api/email'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import type { Email, EmailRevision, EmailRevisionDiff } from '@/types/email'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { FieldError } from '../ui/field'
+import { format } from 'date-fns'
+import { History } from 'lucide-react'
+import { Spinner } from '@/components/ui/spinner'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useEffect, useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useSelfStore from '@/stores/self'
+
+const fieldLabels: Record<string, string> = {
+  name: 'Name',
+  slug: 'Slug',
+  layoutSlug: 'Layout',
+  subject: 'Subject',
+  markdownBody: 'Markdown Body',
+  htmlBody: 'HTML Body',
+  textBody: 'Text Body',
+}
+
+interface EmailRevisionsDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+  email: Email
+  onRestore: (email: Email) => void
+}
+
+export function EmailRevisionsDialog({ open, onOpenChange, email, onRestore }: EmailRevisionsDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [error, setError] = useState<string>('')
+  const [revisions, setRevisions] = useState<Array<EmailRevision> | null>(null)
+  const [diff, setDiff] = useState<EmailRevisionDiff | null>(null)
+
+  if (!open && (revisions !== null || diff !== null || error !== '')) {
+    setRevisions(null)
+    setDiff(null)
+    setError('')
+  }
+
+  useEffect(() => {
+    if (!open || revisions !== null) return
+
+    const load = async () => {
+      setIsLoading(true)
+      try {
+        const result = await fetchEmailRevisions(token || '', email.id)
+        setRevisions([...result].sort((a, b) => b.revision - a.revision))
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          setRevisions([])
+          setError('Unable to load the history of this email')
+        }
+      } finally {
+        setIsLoading(false)
+      }
+    }
+
+    load()
+  }, [open, revisions, email.id, token, navigate])
+
+  const handleCompare = (revision: number) => {
+    return async () => {
+      setIsLoading(true)
+      setError('')
+      try {
+        setDiff(await diffEmailRevisions(token || '', email.id, revision, email.revision || revision))
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          setError('Unable to compare these revisions')
+        }
+      }
+      setIsLoading(false)
+    }
+  }
+
+  const handleRestore = (revision: number) => {
+    return async () => {
+      setIsLoading(true)
+      setError('')
+      try {
+        onRestore(await restoreEmailRevision(token || '', email.id, revision))
+        onOpenChange(false)
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          setError('Unable to restore this revision, check its layout and partials still exist')
+        }
+      }
+      setIsLoading(false)
+    }
+  }
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="sm:max-w-3xl">
+        <DialogHeader>
+          <DialogTitle>
+            {diff ? `Changes From Revision ${diff.from} To ${diff.to}` : `History of ${email.name}`}
+          </DialogTitle>
+        </DialogHeader>
+
+        <div className="no-scrollbar -mx-4 max-h-[75vh] overflow-y-auto px-4">
+          {revisions === null && (
+            <Empty>
+              <EmptyHeader>
+                <EmptyMedia variant="icon">
+                  <Spinner />
+                </EmptyMedia>
+                <EmptyTitle>Loading history...</EmptyTitle>
+              </EmptyHeader>
+            </Empty>
+          )}
+
+          {revisions !== null && revisions.length === 0 && !error && (
+            <Empty>
+              <EmptyHeader>
+                <EmptyMedia variant="icon">
+                  <History />
+                </EmptyMedia>
+                <EmptyTitle>No revisions saved</EmptyTitle>
+              </EmptyHeader>
+            </Empty>
+          )}
+
+          {revisions !== null && revisions.length > 0 && !diff && (
+            <Table>
+              <TableHeader>
+                <TableRow>
+                  <TableHead>Revision</TableHead>
+                  <TableHead>Saved On</TableHead>
+                  <TableHead>Saved By</TableHead>
+                  <TableHead className="text-right">Actions</TableHead>
+                </TableRow>
+              </TableHeader>
+              <TableBody>
+                {revisions.map((revision) => (
+                  <TableRow key={revision.revision}>
+                    <TableCell>
+                      {revision.revision}
+                      {revision.revision === email.revision && ' (current)'}
+                    </TableCell>
+                    <TableCell>{format(revision.createdAt, "MMMM d, yyyy 'at' h:mm aa")}</TableCell>
+                    <TableCell>{revision.createdBy}</TableCell>
+                    <TableCell>
+                      {revision.revision !== email.revision && (
+                        <div className="flex justify-end gap-2">
+                          <Button variant="secondary" disabled={isLoading} onClick={handleCompare(revision.revision)}>
+                            Compare
+                          </Button>
+                          <Button variant="secondary" disabled={isLoading} onClick={handleRestore(revision.revision)}>
+                            Restore
+                          </Button>
+                        </div>
+                      )}
+                    </TableCell>
+                  </TableRow>
+                ))}
+              </TableBody>
+            </Table>
+          )}
+
+          {diff && diff.fields.length === 0 && <p className="text-sm">These revisions are identical.</p>}
+
+          {diff &&
+            diff.fields.map((field) => (
+              <div key={field.field} className="mb-4">
+                <h3 className="mb-1 font-semibold">{fieldLabels[field.field] || field.field}</h3>
+                <pre className="overflow-x-auto rounded-md border text-sm">
+                  {field.lines.map((line, index) => (
+                    <div
+                      key={index}
+                      className={cn('px-2 whitespace-pre', {
+                        'bg-green-50 text-green-900': line.op === 'insert',
+                        'bg-red-50 text-red-900': line.op === 'delete',
+                      })}>
+                      {line.op === 'insert' ? '+ ' : line.op === 'delete' ? '- ' : '  '}
+                      {line.text}
+                    </div>
+                  ))}
+                </pre>
+              </div>
+            ))}
+
+          <FieldError>{error}</FieldError>
+        </div>
+
+        <DialogFooter>
+          {diff ? (
+            <>
+              <Button disabled={isLoading} variant="secondary" type="button" onClick={() => setDiff(null)}>
+                Back
+              </Button>
+              <Button disabled={isLoading} variant="default" type="button" onClick={handleRestore(diff.from)}>
+                Restore Revision {diff.from}
+              </Button>
+            </>
+          ) : (
+            <Button disabled={isLoading} variant="secondary" type="button" onClick={() => onOpenChange(false)}>
+              Close
+            </Button>
+          )}
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
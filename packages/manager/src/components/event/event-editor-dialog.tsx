@@ -0,0 +1,400 @@
+'use client'
+
+import type { Asset } from '@/types/asset'
+import { Button } from '@/components/ui/button'
+import { createEvent, updateEvent } from '@/api/event'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import type { Event } from '@/types/event'
+import { Field, FieldDescription, FieldError, FieldLabel } from '../ui/field'
+import type { Form } from '@/types/form'
+import { format } from 'date-fns'
+import { Input } from '@/components/ui/input'
+import type { Location } from '@/types/location'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { Switch } from '@/components/ui/switch'
+import { Textarea } from '../ui/textarea'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCallback, useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useEventStore from '@/stores/event'
+import useSelfStore from '@/stores/self'
+
+interface FormData {
+  id: number
+  title: string
+  startsOn: string
+  endsOn: string
+  locationId: string
+  imageAssetId: string
+  description: string
+  formId: string
+  link: string
+  published: boolean
+  categories: string
+}
+
+const emptyFormData: FormData = {
+  id: 0,
+  title: '',
+  startsOn: '',
+  endsOn: '',
+  locationId: '',
+  imageAssetId: '',
+  description: '',
+  formId: '',
+  link: '',
+  published: false,
+  categories: '',
+}
+
+const emptyFormError = {
+  title: '',
+  startsOn: '',
+  endsOn: '',
+  link: '',
+}
+
+function toDatetimeLocal(ms: number | undefined): string {
+  return ms ? format(ms, "yyyy-MM-dd'T'HH:mm") : ''
+}
+
+function toOptionalId(value: string): number | undefined {
+  return value ? Number(value) : undefined
+}
+
+function dataFromEvent(event: Event): FormData {
+  return {
+    id: event.id,
+    title: event.title,
+    startsOn: toDatetimeLocal(event.startsOn),
+    endsOn: toDatetimeLocal(event.endsOn),
+    locationId: event.locationId != null ? String(event.locationId) : '',
+    imageAssetId: event.imageAssetId != null ? String(event.imageAssetId) : '',
+    description: event.description,
+    formId: event.formId != null ? String(event.formId) : '',
+    link: event.link,
+    published: event.published,
+    categories: event.categories.join(', '),
+  }
+}
+
+interface EventEditorDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+  initialEvent?: Event
+  locations: Array<Location>
+  assets: Array<Asset>
+  forms: Array<Form>
+}
+
+export function EventEditorDialog({
+  open,
+  onOpenChange,
+  initialEvent,
+  locations,
+  assets,
+  forms,
+}: EventEditorDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+  const { populateSingle } = useEventStore()
+
+  const isEditing = initialEvent !== undefined
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [formError, setFormError] = useState(emptyFormError)
+  const [formData, setFormData] = useState<FormData>(emptyFormData)
+
+  if (
+    !open &&
+    (formData.id !== 0 ||
+      formData.title !== '' ||
+      formData.startsOn !== '' ||
+      formData.endsOn !== '' ||
+      formData.locationId !== '' ||
+      formData.imageAssetId !== '' ||
+      formData.description !== '' ||
+      formData.formId !== '' ||
+      formData.link !== '' ||
+      formData.categories !== '' ||
+      formData.published)
+  ) {
+    setFormData(emptyFormData)
+    setFormError(emptyFormError)
+  }
+
+  if (open && formData.id === 0 && initialEvent != null) {
+    setFormData(dataFromEvent(initialEvent))
+  }
+
+  const handleChange = useCallback((e: React.ChangeEvent<HTMLInputElement | HTMLTextAreaElement>) => {
+    const { name, value } = e.target
+    setFormData((prev) => ({ ...prev, [name]: value }))
+  }, [])
+
+  const handleSelectChange = useCallback((name: keyof FormData) => {
+    return (value: string) => {
+      setFormData((prev) => ({ ...prev, [name]: value === '_none' ? '' : value }))
+    }
+  }, [])
+
+  const handleCancel = useCallback(() => {
+    onOpenChange(false)
+  }, [onOpenChange])
+
+  const handleSubmit = useCallback(
+    async (e: React.MouseEvent<HTMLButtonElement>) => {
+      e.preventDefault()
+      let hasError = false
+      const nextError = { ...emptyFormError }
+
+      if (!formData.title.trim()) {
+        hasError = true
+        nextError.title = 'Please fill in this field'
+      }
+
+      const startsOn = formData.startsOn ? new Date(formData.startsOn).getTime() : NaN
+      if (isNaN(startsOn)) {
+        hasError = true
+        nextError.startsOn = 'Please enter a start date and time'
+      }
+
+      const endsOn = formData.endsOn ? new Date(formData.endsOn).getTime() : undefined
+      if (endsOn !== undefined && (isNaN(endsOn) || endsOn <= startsOn)) {
+        hasError = true
+        nextError.endsOn = 'The end must be after the start'
+      }
+
+      if (formData.link.trim() && !formData.link.trim().startsWith('http')) {
+        hasError = true
+        nextError.link = 'URL must start with http or https'
+      }
+
+      setFormError(nextError)
+
+      if (!hasError) {
+        setIsLoading(true)
+        try {
+          const payload = {
+            id: formData.id,
+            title: formData.title.trim(),
+            startsOn,
+            endsOn,
+            locationId: toOptionalId(formData.locationId),
+            imageAssetId: toOptionalId(formData.imageAssetId),
+            description: formData.description.trim(),
+            formId: toOptionalId(formData.formId),
+            link: formData.link.trim(),
+            published: formData.published,
+            categories: formData.categories
+              .split(',')
+              .map((category) => category.trim())
+              .filter((category) => category !== ''),
+          }
+          const event = isEditing ? await updateEvent(token || '', payload) : await createEvent(token || '', payload)
+          populateSingle(event)
+          onOpenChange(false)
+        } catch (error) {
+          if (error instanceof UnauthorizedError) {
+            navigate({ to: '/manage/login' })
+          }
+        }
+        setIsLoading(false)
+      }
+    },
+    [formData, isEditing, populateSingle, onOpenChange, token, navigate],
+  )
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>{isEditing ? 'Edit Event' : 'Create Event'}</DialogTitle>
+        </DialogHeader>
+
+        <div className="no-scrollbar -mx-4 max-h-[75vh] overflow-y-auto px-4">
+          <form onSubmit={() => false}>
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="title">Title</FieldLabel>
+                <Input
+                  id="title"
+                  name="title"
+                  type="text"
+                  value={formData.title}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                  required
+                />
+                <FieldError>{formError.title}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="startsOn">Starts</FieldLabel>
+                <Input
+                  id="startsOn"
+                  name="startsOn"
+                  type="datetime-local"
+                  value={formData.startsOn}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                  required
+                />
+                <FieldError>{formError.startsOn}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="endsOn">Ends</FieldLabel>
+                <Input
+                  id="endsOn"
+                  name="endsOn"
+                  type="datetime-local"
+                  value={formData.endsOn}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                />
+                <FieldError>{formError.endsOn}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="locationId">Location</FieldLabel>
+                <Select
+                  value={formData.locationId || '_none'}
+                  onValueChange={handleSelectChange('locationId')}
+                  disabled={isLoading}>
+                  <SelectTrigger id="locationId" className="w-full">
+                    <SelectValue placeholder="None" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="_none">None</SelectItem>
+                    {locations.map((location) => (
+                      <SelectItem key={location.id} value={String(location.id)}>
+                        {location.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="imageAssetId">Image</FieldLabel>
+                <Select
+                  value={formData.imageAssetId || '_none'}
+                  onValueChange={handleSelectChange('imageAssetId')}
+                  disabled={isLoading}>
+                  <SelectTrigger id="imageAssetId" className="w-full">
+                    <SelectValue placeholder="None" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="_none">None</SelectItem>
+                    {assets.map((asset) => (
+                      <SelectItem key={asset.id} value={String(asset.id)}>
+                        {asset.fileName}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="formId">Registration Form</FieldLabel>
+                <Select
+                  value={formData.formId || '_none'}
+                  onValueChange={handleSelectChange('formId')}
+                  disabled={isLoading}>
+                  <SelectTrigger id="formId" className="w-full">
+                    <SelectValue placeholder="None" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="_none">None</SelectItem>
+                    {forms.map((form) => (
+                      <SelectItem key={form.id} value={String(form.id)}>
+                        {form.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="link">Link</FieldLabel>
+                <FieldDescription>The event's page on the website, if it has one</FieldDescription>
+                <Input
+                  id="link"
+                  name="link"
+                  type="text"
+                  value={formData.link}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                />
+                <FieldError>{formError.link}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="categories">Categories</FieldLabel>
+                <FieldDescription>
+                  Comma separated programs, such as QTBIPOC Night, each with its own feed for partners
+                </FieldDescription>
+                <Input
+                  id="categories"
+                  name="categories"
+                  type="text"
+                  value={formData.categories}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                />
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="description">Description</FieldLabel>
+                <Textarea
+                  id="description"
+                  value={formData.description}
+                  name="description"
+                  rows={3}
+                  disabled={isLoading}
+                  onChange={handleChange}
+                />
+              </Field>
+            </div>
+
+            <Field orientation="horizontal">
+              <FieldLabel htmlFor="published">Published</FieldLabel>
+              <Switch
+                id="published"
+                checked={formData.published}
+                disabled={isLoading}
+                onCheckedChange={(checked) => setFormData((prev) => ({ ...prev, published: checked }))}
+              />
+            </Field>
+          </form>
+        </div>
+
+        <DialogFooter>
+          <Button disabled={isLoading} variant="secondary" type="button" onClick={handleCancel}>
+            Cancel
+          </Button>
+          <Button disabled={isLoading} variant="default" type="button" onClick={handleSubmit}>
+            {isEditing ? 'Save' : 'Create'}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
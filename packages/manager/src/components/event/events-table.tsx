@@ -0,0 +1,84 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import type { Event } from '@/types/event'
+import { format } from 'date-fns'
+import type { Location } from '@/types/location'
+import { SquareArrowOutUpRight } from 'lucide-react'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+
+export function EventsTable({
+  data,
+  locations,
+  canEdit,
+  onEdit,
+  onDelete,
+}: {
+  data: Array<Event>
+  locations: Record<number, Location>
+  canEdit: boolean
+  onEdit: (id: number) => void
+  onDelete: (id: number) => void
+}) {
+  const handleEdit = (id: number) => {
+    return () => {
+      onEdit(id)
+    }
+  }
+
+  const handleDelete = (id: number) => {
+    return () => {
+      onDelete(id)
+    }
+  }
+
+  return (
+    <div className="overflow-x-auto">
+      <Table>
+        <TableHeader>
+          <TableRow>
+            <TableHead>Title</TableHead>
+            <TableHead>Starts</TableHead>
+            <TableHead>Location</TableHead>
+            <TableHead>Published</TableHead>
+            {canEdit && <TableHead className="text-right">Actions</TableHead>}
+          </TableRow>
+        </TableHeader>
+        <TableBody>
+          {[...data]
+            .sort((a, b) => a.startsOn - b.startsOn)
+            .map((item) => (
+              <TableRow key={item.id}>
+                <TableCell>
+                  {item.link ? (
+                    <a href={item.link} target="_blank" className="group hover:underline">
+                      {item.title} <SquareArrowOutUpRight className="size-3 inline invisible group-hover:visible" />
+                    </a>
+                  ) : (
+                    item.title
+                  )}
+                </TableCell>
+                <TableCell>{format(item.startsOn, "EEEE, MMMM d, yyyy 'at' h:mm aa")}</TableCell>
+                <TableCell>
+                  {item.locationId != null ? (locations[item.locationId]?.name ?? `#${item.locationId}`) : '-'}
+                </TableCell>
+                <TableCell>{item.published ? 'Yes' : 'No'}</TableCell>
+                {canEdit && (
+                  <TableCell>
+                    <div className="flex justify-end gap-2">
+                      <Button variant="secondary" onClick={handleEdit(item.id)}>
+                        Edit
+                      </Button>
+                      <Button variant="destructive" onClick={handleDelete(item.id)}>
+                        Delete
+                      </Button>
+                    </div>
+                  </TableCell>
+                )}
+              </TableRow>
+            ))}
+        </TableBody>
+      </Table>
+    </div>
+  )
+}
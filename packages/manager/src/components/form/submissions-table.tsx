@@ -0,0 +1,36 @@
+'use client'
+
+import type { Submission } from '@/types/form'
+import { format } from 'date-fns'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+
+export function SubmissionsTable({ data }: { data: Array<Submission> }) {
+  const fieldSlugs = Array.from(new Set(data.flatMap((item) => item.values.map((value) => value.fieldSlug))))
+
+  return (
+    <div className="overflow-x-auto">
+      <Table>
+        <TableHeader>
+          <TableRow>
+            <TableHead>Reference</TableHead>
+            <TableHead>Submitted On</TableHead>
+            {fieldSlugs.map((slug) => (
+              <TableHead key={slug}>{slug}</TableHead>
+            ))}
+          </TableRow>
+        </TableHeader>
+        <TableBody>
+          {data.map((item) => (
+            <TableRow key={item.id}>
+              <TableCell className="font-mono">{item.reference}</TableCell>
+              <TableCell>{format(item.submittedOn, "MMMM d, yyyy 'at' h:mm aa")}</TableCell>
+              {fieldSlugs.map((slug) => (
+                <TableCell key={slug}>{item.values.find((value) => value.fieldSlug === slug)?.value || '-'}</TableCell>
+              ))}
+            </TableRow>
+          ))}
+        </TableBody>
+      </Table>
+    </div>
+  )
+}
@@ -24,6 +24,9 @@ interface FormData {
   startTime: string
   endTime: string
   description: string
+  latitude: string
+  longitude: string
+  keywords: string
 }
 
 const emptyFormData: FormData = {
@@ -37,6 +40,9 @@ const emptyFormData: FormData = {
   startTime: '',
   endTime: '',
   description: '',
+  latitude: '',
+  longitude: '',
+  keywords: '',
 }
 
 const emptyFormError = {
@@ -49,6 +55,9 @@ const emptyFormError = {
   startTime: '',
   endTime: '',
   description: '',
+  latitude: '',
+  longitude: '',
+  keywords: '',
 }
 
 function dataFromLocation(location: Location): FormData {
@@ -63,6 +72,9 @@ function dataFromLocation(location: Location): FormData {
     startTime: location.startTime,
     endTime: location.endTime,
     description: location.description,
+    latitude: location.latitude !== undefined ? String(location.latitude) : '',
+    longitude: location.longitude !== undefined ? String(location.longitude) : '',
+    keywords: location.keywords,
   }
 }
 
@@ -94,7 +106,10 @@ export function LocationEditorDialog({ open, onOpenChange, initialLocation }: Lo
       formData.address !== '' ||
       formData.startTime !== '' ||
       formData.endTime !== '' ||
-      formData.description !== '')
+      formData.description !== '' ||
+      formData.latitude !== '' ||
+      formData.longitude !== '' ||
+      formData.keywords !== '')
   ) {
     setFormData(emptyFormData)
     setFormError(emptyFormError)
@@ -175,6 +190,22 @@ export function LocationEditorDialog({ open, onOpenChange, initialLocation }: Lo
         nextError.description = 'Please fill in this field'
       }
 
+      const latitude = formData.latitude.trim()
+      const longitude = formData.longitude.trim()
+      if (latitude || longitude) {
+        const lat = Number(latitude)
+        const lon = Number(longitude)
+        if (!latitude || Number.isNaN(lat) || lat < -90 || lat > 90) {
+          hasError = true
+          nextError.latitude = 'Please enter a latitude between -90 and 90'
+        }
+
+        if (!longitude || Number.isNaN(lon) || lon < -180 || lon > 180) {
+          hasError = true
+          nextError.longitude = 'Please enter a longitude between -180 and 180'
+        }
+      }
+
       setFormError(nextError)
 
       if (!hasError) {
@@ -191,6 +222,9 @@ export function LocationEditorDialog({ open, onOpenChange, initialLocation }: Lo
             startTime: formData.startTime.trim(),
             endTime: formData.endTime.trim(),
             description: formData.description.trim(),
+            latitude: latitude ? Number(latitude) : undefined,
+            longitude: longitude ? Number(longitude) : undefined,
+            keywords: formData.keywords.trim(),
           }
           const location = isEditing
             ? await updateLocation(token || '', payload)
@@ -347,6 +381,58 @@ export function LocationEditorDialog({ open, onOpenChange, initialLocation }: Lo
               </Field>
             </div>
 
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="latitude">Latitude</FieldLabel>
+                <FieldDescription>Optional, used to place events on a map in calendar apps</FieldDescription>
+                <Input
+                  id="latitude"
+                  name="latitude"
+                  type="text"
+                  inputMode="decimal"
+                  value={formData.latitude}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                />
+                <FieldError>{formError.latitude}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="longitude">Longitude</FieldLabel>
+                <Input
+                  id="longitude"
+                  name="longitude"
+                  type="text"
+                  inputMode="decimal"
+                  value={formData.longitude}
+                  onChange={handleChange}
+                  disabled={isLoading}
+                />
+                <FieldError>{formError.longitude}</FieldError>
+              </Field>
+            </div>
+
+            <div className="mb-4">
+              <Field>
+                <FieldLabel htmlFor="keywords">Keywords</FieldLabel>
+                <FieldDescription>
+                  Optional, one per line. Events mentioning any of these in their title or description are shown at
+                  this location
+                </FieldDescription>
+                <Textarea
+                  id="keywords"
+                  value={formData.keywords}
+                  name="keywords"
+                  rows={2}
+                  disabled={isLoading}
+                  onChange={handleTextareaChange}
+                />
+                <FieldError>{formError.keywords}</FieldError>
+              </Field>
+            </div>
+
             <Field>
               <FieldLabel htmlFor="description">Description</FieldLabel>
               <Textarea
@@ -2,7 +2,8 @@
 
 import { Button } from '@/components/ui/button'
 import { cn } from '@/lib/utils'
-import { LogOut, Menu, X } from 'lucide-react'
+import { DigestDialog } from '@/components/digest-dialog'
+import { LogOut, Menu, Newspaper, X } from 'lucide-react'
 import { Link, useLocation, useNavigate } from '@tanstack/react-router'
 import { NAVIGATION_ITEMS } from '@/lib/navigation-items'
 import { useState } from 'react'
@@ -13,6 +14,7 @@ export function Navigation() {
   const navigate = useNavigate()
   const { hasPermission, logout } = useSelfStore()
   const [isOpen, setIsOpen] = useState(false)
+  const [isDigestOpen, setIsDigestOpen] = useState(false)
 
   const toggleSidebar = () => {
     setIsOpen(!isOpen)
@@ -86,6 +88,12 @@ export function Navigation() {
           </nav>
 
           <div className="mt-auto border-t px-3 py-4">
+            <button
+              onClick={() => setIsDigestOpen(true)}
+              className="flex items-center rounded-md px-3 py-2 text-sm font-medium transition-colors w-full hover:bg-sidebar-accent hover:text-sidebar-accent-foreground">
+              <Newspaper className="mr-3 h-5 w-5" />
+              Weekly Digest
+            </button>
             <button
               onClick={handleLogout}
               className="flex items-center rounded-md px-3 py-2 text-sm font-medium transition-colors w-full hover:bg-sidebar-accent hover:text-sidebar-accent-foreground">
@@ -98,6 +106,8 @@ export function Navigation() {
 
       {/* Overlay for mobile */}
       {isOpen && <div className="fixed inset-0 z-30 bg-black/50 md:hidden" onClick={toggleSidebar} />}
+
+      <DigestDialog open={isDigestOpen} onOpenChange={setIsDigestOpen} />
     </>
   )
 }
@@ -0,0 +1,67 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import { format } from 'date-fns'
+import type { OutboundEmail } from '@/types/outbound-email'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+
+export function OutboundEmailsTable({
+  data,
+  canEdit,
+  onRetry,
+}: {
+  data: Array<OutboundEmail>
+  canEdit: boolean
+  onRetry: (id: number) => void
+}) {
+  const handleRetry = (id: number) => {
+    return () => {
+      onRetry(id)
+    }
+  }
+
+  return (
+    <div className="overflow-x-auto">
+      <Table>
+        <TableHeader>
+          <TableRow>
+            <TableHead>Queued On</TableHead>
+            <TableHead>Email</TableHead>
+            <TableHead>To</TableHead>
+            <TableHead>Subject</TableHead>
+            <TableHead>Status</TableHead>
+            <TableHead>Attempts</TableHead>
+            <TableHead>Last Error</TableHead>
+            {canEdit && <TableHead className="text-right">Actions</TableHead>}
+          </TableRow>
+        </TableHeader>
+        <TableBody>
+          {data.map((item) => (
+            <TableRow key={item.id}>
+              <TableCell>{format(item.createdAt, "MMMM d, yyyy 'at' h:mm aa")}</TableCell>
+              <TableCell>{item.emailSlug}</TableCell>
+              <TableCell>{item.to.join(', ')}</TableCell>
+              <TableCell>{item.subject || '-'}</TableCell>
+              <TableCell className="capitalize">{item.status}</TableCell>
+              <TableCell>{item.attempts}</TableCell>
+              <TableCell className="max-w-xs truncate" title={item.lastError}>
+                {item.lastError || '-'}
+              </TableCell>
+              {canEdit && (
+                <TableCell>
+                  <div className="flex justify-end gap-2">
+                    {item.status === 'failed' && (
+                      <Button variant="secondary" onClick={handleRetry(item.id)}>
+                        Retry
+                      </Button>
+                    )}
+                  </div>
+                </TableCell>
+              )}
+            </TableRow>
+          ))}
+        </TableBody>
+      </Table>
+    </div>
+  )
+}
@@ -0,0 +1,97 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import { createEmailSuppression } from '@/api/email-suppression'
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog'
+import { Field, FieldError, FieldLabel } from '../ui/field'
+import { Input } from '@/components/ui/input'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCallback, useState } from 'react'
+import { useNavigate } from '@tanstack/react-router'
+import useEmailSuppressionStore from '@/stores/email-suppression'
+import useSelfStore from '@/stores/self'
+
+interface EmailSuppressionDialogProps {
+  open: boolean
+  onOpenChange: (isOpen: boolean) => void
+}
+
+export function EmailSuppressionDialog({ open, onOpenChange }: EmailSuppressionDialogProps) {
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+  const { populateSingle } = useEmailSuppressionStore()
+
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [address, setAddress] = useState<string>('')
+  const [addressError, setAddressError] = useState<string>('')
+
+  if (!open && (address !== '' || addressError !== '')) {
+    setAddress('')
+    setAddressError('')
+  }
+
+  const handleCancel = useCallback(() => {
+    onOpenChange(false)
+  }, [onOpenChange])
+
+  const handleSubmit = useCallback(
+    async (e: React.MouseEvent<HTMLButtonElement>) => {
+      e.preventDefault()
+
+      if (!address.trim().includes('@')) {
+        setAddressError('Please enter an email address')
+        return
+      }
+
+      setIsLoading(true)
+      try {
+        const suppression = await createEmailSuppression(token || '', address.trim())
+        populateSingle(suppression)
+        onOpenChange(false)
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          setAddressError('Unable to suppress this address')
+        }
+      }
+      setIsLoading(false)
+    },
+    [address, populateSingle, onOpenChange, token, navigate],
+  )
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent>
+        <DialogHeader>
+          <DialogTitle>Suppress Address</DialogTitle>
+        </DialogHeader>
+
+        <form onSubmit={() => false}>
+          <Field>
+            <FieldLabel htmlFor="address">Email Address</FieldLabel>
+            <Input
+              id="address"
+              name="address"
+              type="email"
+              value={address}
+              onChange={(e) => setAddress(e.target.value)}
+              disabled={isLoading}
+              required
+            />
+            <FieldError>{addressError}</FieldError>
+          </Field>
+        </form>
+
+        <DialogFooter>
+          <Button disabled={isLoading} variant="secondary" type="button" onClick={handleCancel}>
+            Cancel
+          </Button>
+          <Button disabled={isLoading} variant="default" type="button" onClick={handleSubmit}>
+            Suppress
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  )
+}
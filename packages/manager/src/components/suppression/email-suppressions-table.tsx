@@ -0,0 +1,55 @@
+'use client'
+
+import { Button } from '@/components/ui/button'
+import type { EmailSuppression } from '@/types/email-suppression'
+import { format } from 'date-fns'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+
+export function EmailSuppressionsTable({
+  data,
+  canEdit,
+  onDelete,
+}: {
+  data: Array<EmailSuppression>
+  canEdit: boolean
+  onDelete: (id: number) => void
+}) {
+  const handleDelete = (id: number) => {
+    return () => {
+      onDelete(id)
+    }
+  }
+
+  return (
+    <div className="overflow-x-auto">
+      <Table>
+        <TableHeader>
+          <TableRow>
+            <TableHead>Address</TableHead>
+            <TableHead>Reason</TableHead>
+            <TableHead>Suppressed On</TableHead>
+            {canEdit && <TableHead className="text-right">Actions</TableHead>}
+          </TableRow>
+        </TableHeader>
+        <TableBody>
+          {data.map((item) => (
+            <TableRow key={item.id}>
+              <TableCell>{item.address}</TableCell>
+              <TableCell className="capitalize">{item.reason}</TableCell>
+              <TableCell>{format(item.createdAt, "EEEE, MMMM d, yyyy 'at' h:mm aa")}</TableCell>
+              {canEdit && (
+                <TableCell>
+                  <div className="flex justify-end gap-2">
+                    <Button variant="destructive" onClick={handleDelete(item.id)}>
+                      Remove
+                    </Button>
+                  </div>
+                </TableCell>
+              )}
+            </TableRow>
+          ))}
+        </TableBody>
+      </Table>
+    </div>
+  )
+}
@@ -1,4 +1,18 @@
-import { FileText, Image, Mail, MapPin, Upload, User, Users, Waypoints } from 'lucide-react'
+import {
+  CalendarDays,
+  FileText,
+  Image,
+  Inbox,
+  Mail,
+  MailX,
+  MapPin,
+  Megaphone,
+  Puzzle,
+  Upload,
+  User,
+  Users,
+  Waypoints,
+} from 'lucide-react'
 
 export const NAVIGATION_ITEMS = [
   {
@@ -7,12 +21,24 @@ export const NAVIGATION_ITEMS = [
     icon: Upload,
     entity: 'asset',
   },
+  {
+    title: 'Broadcasts',
+    href: '/manage/broadcast',
+    icon: Megaphone,
+    entity: 'email',
+  },
   {
     title: 'Emails',
     href: '/manage/email',
     icon: Mail,
     entity: 'email',
   },
+  {
+    title: 'Events',
+    href: '/manage/event',
+    icon: CalendarDays,
+    entity: 'event',
+  },
   {
     title: 'Forms',
     href: '/manage/form',
@@ -25,6 +51,18 @@ export const NAVIGATION_ITEMS = [
     icon: MapPin,
     entity: 'location',
   },
+  {
+    title: 'Outbox',
+    href: '/manage/outbox',
+    icon: Inbox,
+    entity: 'email',
+  },
+  {
+    title: 'Partials',
+    href: '/manage/partial',
+    icon: Puzzle,
+    entity: 'email',
+  },
   {
     title: 'Redirects',
     href: '/manage/redirect',
@@ -43,6 +81,12 @@ export const NAVIGATION_ITEMS = [
     icon: Image,
     entity: 'social',
   },
+  {
+    title: 'Suppressions',
+    href: '/manage/suppression',
+    icon: MailX,
+    entity: 'email',
+  },
   {
     title: 'Users',
     href: '/manage/users',
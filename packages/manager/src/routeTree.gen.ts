@@ -11,13 +11,18 @@
 import { Route as rootRouteImport } from './routes/__root'
 import { Route as ManageIndexRouteImport } from './routes/manage_/index'
 import { Route as ManageUsersRouteImport } from './routes/manage_/users'
+import { Route as ManageSuppressionRouteImport } from './routes/manage_/suppression'
 import { Route as ManageRolesRouteImport } from './routes/manage_/roles'
 import { Route as ManageResetRouteImport } from './routes/manage_/reset'
 import { Route as ManageRedirectRouteImport } from './routes/manage_/redirect'
+import { Route as ManagePartialRouteImport } from './routes/manage_/partial'
+import { Route as ManageOutboxRouteImport } from './routes/manage_/outbox'
 import { Route as ManageLoginRouteImport } from './routes/manage_/login'
 import { Route as ManageLocationRouteImport } from './routes/manage_/location'
 import { Route as ManageFormRouteImport } from './routes/manage_/form'
+import { Route as ManageEventRouteImport } from './routes/manage_/event'
 import { Route as ManageEmailRouteImport } from './routes/manage_/email'
+import { Route as ManageBroadcastRouteImport } from './routes/manage_/broadcast'
 import { Route as ManageAssetRouteImport } from './routes/manage_/asset'
 import { Route as ManageSocialImagesIndexRouteImport } from './routes/manage_/social-images/index'
 import { Route as ManageSocialImagesQtbipocRouteImport } from './routes/manage_/social-images/qtbipoc'
@@ -38,6 +43,11 @@ const ManageUsersRoute = ManageUsersRouteImport.update({
   path: '/manage/users',
   getParentRoute: () => rootRouteImport,
 } as any)
+const ManageSuppressionRoute = ManageSuppressionRouteImport.update({
+  id: '/manage_/suppression',
+  path: '/manage/suppression',
+  getParentRoute: () => rootRouteImport,
+} as any)
 const ManageRolesRoute = ManageRolesRouteImport.update({
   id: '/manage_/roles',
   path: '/manage/roles',
@@ -53,6 +63,16 @@ const ManageRedirectRoute = ManageRedirectRouteImport.update({
   path: '/manage/redirect',
   getParentRoute: () => rootRouteImport,
 } as any)
+const ManagePartialRoute = ManagePartialRouteImport.update({
+  id: '/manage_/partial',
+  path: '/manage/partial',
+  getParentRoute: () => rootRouteImport,
+} as any)
+const ManageOutboxRoute = ManageOutboxRouteImport.update({
+  id: '/manage_/outbox',
+  path: '/manage/outbox',
+  getParentRoute: () => rootRouteImport,
+} as any)
 const ManageLoginRoute = ManageLoginRouteImport.update({
   id: '/manage_/login',
   path: '/manage/login',
@@ -68,11 +88,21 @@ const ManageFormRoute = ManageFormRouteImport.update({
   path: '/manage/form',
   getParentRoute: () => rootRouteImport,
 } as any)
+const ManageEventRoute = ManageEventRouteImport.update({
+  id: '/manage_/event',
+  path: '/manage/event',
+  getParentRoute: () => rootRouteImport,
+} as any)
 const ManageEmailRoute = ManageEmailRouteImport.update({
   id: '/manage_/email',
   path: '/manage/email',
   getParentRoute: () => rootRouteImport,
 } as any)
+const ManageBroadcastRoute = ManageBroadcastRouteImport.update({
+  id: '/manage_/broadcast',
+  path: '/manage/broadcast',
+  getParentRoute: () => rootRouteImport,
+} as any)
 const ManageAssetRoute = ManageAssetRouteImport.update({
   id: '/manage_/asset',
   path: '/manage/asset',
@@ -123,13 +153,18 @@ const ManageEmailIdEditRoute = ManageEmailIdEditRouteImport.update({
 
 export interface FileRoutesByFullPath {
   '/manage/asset': typeof ManageAssetRoute
+  '/manage/broadcast': typeof ManageBroadcastRoute
   '/manage/email': typeof ManageEmailRoute
+  '/manage/event': typeof ManageEventRoute
   '/manage/form': typeof ManageFormRoute
   '/manage/location': typeof ManageLocationRoute
   '/manage/login': typeof ManageLoginRoute
+  '/manage/outbox': typeof ManageOutboxRoute
+  '/manage/partial': typeof ManagePartialRoute
   '/manage/redirect': typeof ManageRedirectRoute
   '/manage/reset': typeof ManageResetRoute
   '/manage/roles': typeof ManageRolesRoute
+  '/manage/suppression': typeof ManageSuppressionRoute
   '/manage/users': typeof ManageUsersRoute
   '/manage/': typeof ManageIndexRoute
   '/manage/email/create': typeof ManageEmailCreateRoute
@@ -143,13 +178,18 @@ export interface FileRoutesByFullPath {
 }
 export interface FileRoutesByTo {
   '/manage/asset': typeof ManageAssetRoute
+  '/manage/broadcast': typeof ManageBroadcastRoute
   '/manage/email': typeof ManageEmailRoute
+  '/manage/event': typeof ManageEventRoute
   '/manage/form': typeof ManageFormRoute
   '/manage/location': typeof ManageLocationRoute
   '/manage/login': typeof ManageLoginRoute
+  '/manage/outbox': typeof ManageOutboxRoute
+  '/manage/partial': typeof ManagePartialRoute
   '/manage/redirect': typeof ManageRedirectRoute
   '/manage/reset': typeof ManageResetRoute
   '/manage/roles': typeof ManageRolesRoute
+  '/manage/suppression': typeof ManageSuppressionRoute
   '/manage/users': typeof ManageUsersRoute
   '/manage': typeof ManageIndexRoute
   '/manage/email/create': typeof ManageEmailCreateRoute
@@ -164,13 +204,18 @@ export interface FileRoutesByTo {
 export interface FileRoutesById {
   __root__: typeof rootRouteImport
   '/manage_/asset': typeof ManageAssetRoute
+  '/manage_/broadcast': typeof ManageBroadcastRoute
   '/manage_/email': typeof ManageEmailRoute
+  '/manage_/event': typeof ManageEventRoute
   '/manage_/form': typeof ManageFormRoute
   '/manage_/location': typeof ManageLocationRoute
   '/manage_/login': typeof ManageLoginRoute
+  '/manage_/outbox': typeof ManageOutboxRoute
+  '/manage_/partial': typeof ManagePartialRoute
   '/manage_/redirect': typeof ManageRedirectRoute
   '/manage_/reset': typeof ManageResetRoute
   '/manage_/roles': typeof ManageRolesRoute
+  '/manage_/suppression': typeof ManageSuppressionRoute
   '/manage_/users': typeof ManageUsersRoute
   '/manage_/': typeof ManageIndexRoute
   '/manage_/email_/create': typeof ManageEmailCreateRoute
@@ -186,13 +231,18 @@ export interface FileRouteTypes {
   fileRoutesByFullPath: FileRoutesByFullPath
   fullPaths:
     | '/manage/asset'
+    | '/manage/broadcast'
     | '/manage/email'
+    | '/manage/event'
     | '/manage/form'
     | '/manage/location'
     | '/manage/login'
+    | '/manage/outbox'
+    | '/manage/partial'
     | '/manage/redirect'
     | '/manage/reset'
     | '/manage/roles'
+    | '/manage/suppression'
     | '/manage/users'
     | '/manage/'
     | '/manage/email/create'
@@ -206,13 +256,18 @@ export interface FileRouteTypes {
   fileRoutesByTo: FileRoutesByTo
   to:
     | '/manage/asset'
+    | '/manage/broadcast'
     | '/manage/email'
+    | '/manage/event'
     | '/manage/form'
     | '/manage/location'
     | '/manage/login'
+    | '/manage/outbox'
+    | '/manage/partial'
     | '/manage/redirect'
     | '/manage/reset'
     | '/manage/roles'
+    | '/manage/suppression'
     | '/manage/users'
     | '/manage'
     | '/manage/email/create'
@@ -226,13 +281,18 @@ export interface FileRouteTypes {
   id:
     | '__root__'
     | '/manage_/asset'
+    | '/manage_/broadcast'
     | '/manage_/email'
+    | '/manage_/event'
     | '/manage_/form'
     | '/manage_/location'
     | '/manage_/login'
+    | '/manage_/outbox'
+    | '/manage_/partial'
     | '/manage_/redirect'
     | '/manage_/reset'
     | '/manage_/roles'
+    | '/manage_/suppression'
     | '/manage_/users'
     | '/manage_/'
     | '/manage_/email_/create'
@@ -247,13 +307,18 @@ export interface FileRouteTypes {
 }
 export interface RootRouteChildren {
   ManageAssetRoute: typeof ManageAssetRoute
+  ManageBroadcastRoute: typeof ManageBroadcastRoute
   ManageEmailRoute: typeof ManageEmailRoute
+  ManageEventRoute: typeof ManageEventRoute
   ManageFormRoute: typeof ManageFormRoute
   ManageLocationRoute: typeof ManageLocationRoute
   ManageLoginRoute: typeof ManageLoginRoute
+  ManageOutboxRoute: typeof ManageOutboxRoute
+  ManagePartialRoute: typeof ManagePartialRoute
   ManageRedirectRoute: typeof ManageRedirectRoute
   ManageResetRoute: typeof ManageResetRoute
   ManageRolesRoute: typeof ManageRolesRoute
+  ManageSuppressionRoute: typeof ManageSuppressionRoute
   ManageUsersRoute: typeof ManageUsersRoute
   ManageIndexRoute: typeof ManageIndexRoute
   ManageEmailCreateRoute: typeof ManageEmailCreateRoute
@@ -282,6 +347,13 @@ declare module '@tanstack/react-router' {
       preLoaderRoute: typeof ManageUsersRouteImport
       parentRoute: typeof rootRouteImport
     }
+    '/manage_/suppression': {
+      id: '/manage_/suppression'
+      path: '/manage/suppression'
+      fullPath: '/manage/suppression'
+      preLoaderRoute: typeof ManageSuppressionRouteImport
+      parentRoute: typeof rootRouteImport
+    }
     '/manage_/roles': {
       id: '/manage_/roles'
       path: '/manage/roles'
@@ -303,6 +375,20 @@ declare module '@tanstack/react-router' {
       preLoaderRoute: typeof ManageRedirectRouteImport
       parentRoute: typeof rootRouteImport
     }
+    '/manage_/partial': {
+      id: '/manage_/partial'
+      path: '/manage/partial'
+      fullPath: '/manage/partial'
+      preLoaderRoute: typeof ManagePartialRouteImport
+      parentRoute: typeof rootRouteImport
+    }
+    '/manage_/outbox': {
+      id: '/manage_/outbox'
+      path: '/manage/outbox'
+      fullPath: '/manage/outbox'
+      preLoaderRoute: typeof ManageOutboxRouteImport
+      parentRoute: typeof rootRouteImport
+    }
     '/manage_/login': {
       id: '/manage_/login'
       path: '/manage/login'
@@ -324,6 +410,13 @@ declare module '@tanstack/react-router' {
       preLoaderRoute: typeof ManageFormRouteImport
       parentRoute: typeof rootRouteImport
     }
+    '/manage_/event': {
+      id: '/manage_/event'
+      path: '/manage/event'
+      fullPath: '/manage/event'
+      preLoaderRoute: typeof ManageEventRouteImport
+      parentRoute: typeof rootRouteImport
+    }
     '/manage_/email': {
       id: '/manage_/email'
       path: '/manage/email'
@@ -331,6 +424,13 @@ declare module '@tanstack/react-router' {
       preLoaderRoute: typeof ManageEmailRouteImport
       parentRoute: typeof rootRouteImport
     }
+    '/manage_/broadcast': {
+      id: '/manage_/broadcast'
+      path: '/manage/broadcast'
+      fullPath: '/manage/broadcast'
+      preLoaderRoute: typeof ManageBroadcastRouteImport
+      parentRoute: typeof rootRouteImport
+    }
     '/manage_/asset': {
       id: '/manage_/asset'
       path: '/manage/asset'
@@ -399,13 +499,18 @@ declare module '@tanstack/react-router' {
 
 const rootRouteChildren: RootRouteChildren = {
   ManageAssetRoute: ManageAssetRoute,
+  ManageBroadcastRoute: ManageBroadcastRoute,
   ManageEmailRoute: ManageEmailRoute,
+  ManageEventRoute: ManageEventRoute,
   ManageFormRoute: ManageFormRoute,
   ManageLocationRoute: ManageLocationRoute,
   ManageLoginRoute: ManageLoginRoute,
+  ManageOutboxRoute: ManageOutboxRoute,
+  ManagePartialRoute: ManagePartialRoute,
   ManageRedirectRoute: ManageRedirectRoute,
   ManageResetRoute: ManageResetRoute,
   ManageRolesRoute: ManageRolesRoute,
+  ManageSuppressionRoute: ManageSuppressionRoute,
   ManageUsersRoute: ManageUsersRoute,
   ManageIndexRoute: ManageIndexRoute,
   ManageEmailCreateRoute: ManageEmailCreateRoute,
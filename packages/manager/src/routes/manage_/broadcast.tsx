@@ -0,0 +1,138 @@
+'use client'
+
+import authGuard from '@/lib/auth-guard'
+import { BroadcastDialog } from '@/components/broadcast/broadcast-dialog'
+import { BroadcastsTable } from '@/components/broadcast/broadcasts-table'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent } from '@/components/ui/card'
+import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { Content } from '@/components/content'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { fetchBroadcasts } from '@/api/broadcast'
+import { fetchEmails } from '@/api/email'
+import { fetchForms } from '@/api/form'
+import { Header } from '@/components/header'
+import { Megaphone, Send } from 'lucide-react'
+import permissionGuard from '@/lib/permission-guard'
+import { Spinner } from '@/components/ui/spinner'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCrudDialogs } from '@/lib/use-crud-dialogs'
+import { useEffect, useState } from 'react'
+import useBroadcastStore from '@/stores/broadcast'
+import useEmailStore from '@/stores/email'
+import useFormStore from '@/stores/form'
+import useSelfStore, { READ_PERMISSION, WRITE_PERMISSION } from '@/stores/self'
+
+export const Route = createFileRoute('/manage_/broadcast')({
+  component: Broadcasts,
+  head: () => ({
+    meta: [
+      {
+        title: 'Broadcasts | OutClimb Management',
+      },
+    ],
+  }),
+  beforeLoad: ({ context, location }) =>
+    Promise.all([
+      authGuard(context, location),
+      permissionGuard(context, 'email', READ_PERMISSION),
+      permissionGuard(context, 'form', READ_PERMISSION),
+    ]),
+})
+
+function Broadcasts() {
+  const navigate = useNavigate()
+  const { hasPermission, token } = useSelfStore()
+  const { isEmpty, list, populate } = useBroadcastStore()
+  const emailStore = useEmailStore()
+  const formStore = useFormStore()
+
+  const [isHydrated, setIsHydrated] = useState<boolean>(false)
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const { isEditorOpen, handleEditorOpenChange, handleCreate } = useCrudDialogs()
+
+  useEffect(() => {
+    const fetchBroadcastsFromApi = async () => {
+      setIsLoading(true)
+
+      try {
+        const [broadcasts, emails, forms] = await Promise.all([
+          fetchBroadcasts(token || ''),
+          fetchEmails(token || ''),
+          fetchForms(token || ''),
+        ])
+        populate(broadcasts)
+        emailStore.populate(emails)
+        formStore.populate(forms)
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          // Display error
+        }
+      } finally {
+        setIsHydrated(true)
+        setIsLoading(false)
+      }
+    }
+
+    if (!isHydrated) {
+      fetchBroadcastsFromApi()
+    }
+  })
+
+  return (
+    <>
+      <Header
+        actions={
+          hasPermission('email', WRITE_PERMISSION) && (
+            <Button onClick={handleCreate} disabled={isLoading}>
+              <Send />
+              Send Broadcast
+            </Button>
+          )
+        }>
+        Broadcasts
+      </Header>
+
+      <Content>
+        <Card className="p-0">
+          <CardContent className="p-0">
+            {isLoading && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Spinner />
+                  </EmptyMedia>
+                  <EmptyTitle>Loading broadcasts...</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && isEmpty() && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Megaphone />
+                  </EmptyMedia>
+                  <EmptyTitle>No broadcasts sent</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && !isEmpty() && <BroadcastsTable data={list()} forms={formStore.data} />}
+          </CardContent>
+        </Card>
+      </Content>
+
+      {hasPermission('email', WRITE_PERMISSION) && (
+        <BroadcastDialog
+          open={isEditorOpen}
+          onOpenChange={handleEditorOpenChange}
+          emails={emailStore.list()}
+          forms={formStore.list()}
+        />
+      )}
+    </>
+  )
+}
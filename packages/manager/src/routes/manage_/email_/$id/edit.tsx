@@ -7,19 +7,24 @@ import { Button } from '@/components/ui/button'
 import { Card, CardContent, CardFooter } from '@/components/ui/card'
 import { Content } from '@/components/content'
 import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import type { Email } from '@/types/email'
+import { EmailPreviewDialog } from '@/components/email/email-preview-dialog'
+import { EmailRevisionsDialog } from '@/components/email/email-revisions-dialog'
 import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
 import { fetchEmails, updateEmail } from '@/api/email'
+import { fetchForms } from '@/api/form'
 import { Field, FieldLabel } from '@/components/ui/field'
 import { Header } from '@/components/header'
+import { Eye, History, Mail } from 'lucide-react'
 import { Input } from '@/components/ui/input'
-import { Mail } from 'lucide-react'
 import permissionGuard from '@/lib/permission-guard'
 import { Spinner } from '@/components/ui/spinner'
 import { Textarea } from '@/components/ui/textarea'
 import { UnauthorizedError } from '@/errors/unauthorized'
 import { useCallback, useEffect, useState } from 'react'
 import useEmailStore from '@/stores/email'
-import useSelfStore, { WRITE_PERMISSION } from '@/stores/self'
+import useFormStore from '@/stores/form'
+import useSelfStore, { READ_PERMISSION, WRITE_PERMISSION } from '@/stores/self'
 
 export const Route = createFileRoute('/manage_/email_/$id/edit')({
   component: EditEmail,
@@ -40,6 +45,8 @@ interface FormData {
   subject: string
   htmlBody: string
   textBody: string
+  layoutSlug: string | null
+  markdownBody: string | null
 }
 
 interface FormErrors {
@@ -52,28 +59,38 @@ interface FormErrors {
 
 const emptyErrors: FormErrors = { name: '', slug: '', subject: '', htmlBody: '', textBody: '' }
 
+// The layout and Markdown source are not edited here but must survive a save.
+function toFormData(email: Email): FormData {
+  return {
+    name: email.name,
+    slug: email.slug,
+    subject: email.subject,
+    htmlBody: email.htmlBody,
+    textBody: email.textBody,
+    layoutSlug: email.layoutSlug || null,
+    markdownBody: email.markdownBody || null,
+  }
+}
+
 function EditEmail() {
   const { id: idParam } = Route.useParams()
   const id = Number(idParam)
   const navigate = useNavigate()
-  const { token } = useSelfStore()
+  const { hasPermission, token } = useSelfStore()
   const { data, isEmpty, populate, populateSingle } = useEmailStore()
+  const formStore = useFormStore()
 
   const [isHydrated, setIsHydrated] = useState<boolean>(() => !isEmpty())
   const [isLoading, setIsLoading] = useState<boolean>(false)
+  const [isPreviewOpen, setIsPreviewOpen] = useState<boolean>(false)
+  const [isHistoryOpen, setIsHistoryOpen] = useState<boolean>(false)
   const [formErrors, setFormErrors] = useState<FormErrors>(emptyErrors)
   const [formData, setFormData] = useState<FormData>(() => {
     const existing = data[id]
     if (existing) {
-      return {
-        name: existing.name,
-        slug: existing.slug,
-        subject: existing.subject,
-        htmlBody: existing.htmlBody,
-        textBody: existing.textBody,
-      }
+      return toFormData(existing)
     }
-    return { name: '', slug: '', subject: '', htmlBody: '', textBody: '' }
+    return { name: '', slug: '', subject: '', htmlBody: '', textBody: '', layoutSlug: null, markdownBody: null }
   })
 
   useEffect(() => {
@@ -86,13 +103,7 @@ function EditEmail() {
         populate(emails)
         const found = emails.find((e) => e.id === id)
         if (found) {
-          setFormData({
-            name: found.name,
-            slug: found.slug,
-            subject: found.subject,
-            htmlBody: found.htmlBody,
-            textBody: found.textBody,
-          })
+          setFormData(toFormData(found))
         }
       } catch (error) {
         if (error instanceof UnauthorizedError) {
@@ -107,6 +118,28 @@ function EditEmail() {
     load()
   }, [isHydrated, id, token, populate, navigate])
 
+  const handlePreviewOpen = useCallback(async () => {
+    setIsPreviewOpen(true)
+    if (!formStore.isEmpty()) return
+
+    try {
+      formStore.populate(await fetchForms(token || ''))
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      }
+    }
+  }, [formStore, token, navigate])
+
+  const handleRestore = useCallback(
+    (email: Email) => {
+      populateSingle(email)
+      setFormData(toFormData(email))
+      setFormErrors(emptyErrors)
+    },
+    [populateSingle],
+  )
+
   const handleChange = useCallback((e: React.ChangeEvent<HTMLInputElement | HTMLTextAreaElement>) => {
     const { name, value } = e.target
     setFormData((prev) => ({ ...prev, [name]: value }))
@@ -156,6 +189,8 @@ function EditEmail() {
             subject: formData.subject.trim(),
             htmlBody: formData.htmlBody.trim(),
             textBody: formData.textBody.trim(),
+            layoutSlug: formData.layoutSlug,
+            markdownBody: formData.markdownBody,
           })
           populateSingle(email)
           navigate({ to: '/manage/email' })
@@ -175,7 +210,26 @@ function EditEmail() {
 
   return (
     <>
-      <Header backTo="/manage/email">Edit Email</Header>
+      <Header
+        backTo="/manage/email"
+        actions={
+          email && (
+            <div className="flex gap-2">
+              <Button variant="secondary" onClick={() => setIsHistoryOpen(true)} disabled={isLoading}>
+                <History />
+                History
+              </Button>
+              {hasPermission('form', READ_PERMISSION) && (
+                <Button variant="secondary" onClick={handlePreviewOpen} disabled={isLoading}>
+                  <Eye />
+                  Preview
+                </Button>
+              )}
+            </div>
+          )
+        }>
+        Edit Email
+      </Header>
 
       <Content>
         <Card>
@@ -319,6 +373,23 @@ function EditEmail() {
           )}
         </Card>
       </Content>
+
+      {email && (
+        <>
+          <EmailRevisionsDialog
+            open={isHistoryOpen}
+            onOpenChange={setIsHistoryOpen}
+            email={email}
+            onRestore={handleRestore}
+          />
+          <EmailPreviewDialog
+            open={isPreviewOpen}
+            onOpenChange={setIsPreviewOpen}
+            emailId={id}
+            forms={formStore.list()}
+          />
+        </>
+      )}
     </>
   )
 }
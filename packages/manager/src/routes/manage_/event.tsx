@@ -0,0 +1,171 @@
+'use client'
+
+import authGuard from '@/lib/auth-guard'
+import { Button } from '@/components/ui/button'
+import { CalendarDays, Plus } from 'lucide-react'
+import { Card, CardContent } from '@/components/ui/card'
+import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { Content } from '@/components/content'
+import { DeleteDialog } from '@/components/delete-dialog'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { EventEditorDialog } from '@/components/event/event-editor-dialog'
+import { EventsTable } from '@/components/event/events-table'
+import { fetchAssets } from '@/api/asset'
+import { fetchEvents, removeEvent } from '@/api/event'
+import { fetchForms } from '@/api/form'
+import { fetchLocations } from '@/api/location'
+import { Header } from '@/components/header'
+import permissionGuard from '@/lib/permission-guard'
+import { Spinner } from '@/components/ui/spinner'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCrudDialogs } from '@/lib/use-crud-dialogs'
+import { useEffect, useState } from 'react'
+import useAssetStore from '@/stores/asset'
+import useEventStore from '@/stores/event'
+import useFormStore from '@/stores/form'
+import useLocationStore from '@/stores/location'
+import useSelfStore, { READ_PERMISSION, WRITE_PERMISSION } from '@/stores/self'
+
+export const Route = createFileRoute('/manage_/event')({
+  component: Events,
+  head: () => ({
+    meta: [
+      {
+        title: 'Events | OutClimb Management',
+      },
+    ],
+  }),
+  beforeLoad: ({ context, location }) =>
+    Promise.all([authGuard(context, location), permissionGuard(context, 'event', READ_PERMISSION)]),
+})
+
+function Events() {
+  const navigate = useNavigate()
+  const { hasPermission, token } = useSelfStore()
+  const { data, isEmpty, list, populate, remove } = useEventStore()
+  const { data: locations, list: locationList, populate: populateLocations } = useLocationStore()
+  const { list: assetList, populate: populateAssets } = useAssetStore()
+  const { list: formList, populate: populateForms } = useFormStore()
+
+  const [isHydrated, setIsHydrated] = useState<boolean>(false)
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const {
+    selectedId,
+    isEditorOpen,
+    handleEditorOpenChange,
+    isDeleteDialogOpen,
+    handleCreate,
+    handleEdit,
+    handleDelete,
+    handleDeleteDialogOpenChange,
+  } = useCrudDialogs()
+
+  useEffect(() => {
+    const fetchEventsFromApi = async () => {
+      setIsLoading(true)
+
+      try {
+        // Only load the lists the user can see, the editor falls back to
+        // fewer choices without them.
+        const [events, locations, assets, forms] = await Promise.all([
+          fetchEvents(token || ''),
+          hasPermission('location', READ_PERMISSION) ? fetchLocations(token || '') : Promise.resolve([]),
+          hasPermission('asset', READ_PERMISSION) ? fetchAssets(token || '') : Promise.resolve([]),
+          hasPermission('form', READ_PERMISSION) ? fetchForms(token || '') : Promise.resolve([]),
+        ])
+        populate(events)
+        populateLocations(locations)
+        populateAssets(assets)
+        populateForms(forms)
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          // Display error
+        }
+      } finally {
+        setIsHydrated(true)
+        setIsLoading(false)
+      }
+    }
+
+    if (!isHydrated) {
+      fetchEventsFromApi()
+    }
+  })
+
+  return (
+    <>
+      <Header
+        actions={
+          hasPermission('event', WRITE_PERMISSION) && (
+            <Button onClick={handleCreate} disabled={isLoading}>
+              <Plus />
+              Create Event
+            </Button>
+          )
+        }>
+        Events
+      </Header>
+
+      <Content>
+        <Card className="p-0">
+          <CardContent className="p-0">
+            {isLoading && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Spinner />
+                  </EmptyMedia>
+                  <EmptyTitle>Loading events...</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && isEmpty() && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <CalendarDays />
+                  </EmptyMedia>
+                  <EmptyTitle>No events created</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && !isEmpty() && (
+              <EventsTable
+                data={list()}
+                locations={locations}
+                canEdit={hasPermission('event', WRITE_PERMISSION)}
+                onEdit={handleEdit}
+                onDelete={handleDelete}
+              />
+            )}
+          </CardContent>
+        </Card>
+      </Content>
+
+      {hasPermission('event', WRITE_PERMISSION) && (
+        <>
+          <EventEditorDialog
+            open={isEditorOpen}
+            onOpenChange={handleEditorOpenChange}
+            initialEvent={selectedId != null ? data[selectedId] : undefined}
+            locations={locationList()}
+            assets={assetList()}
+            forms={formList()}
+          />
+          <DeleteDialog
+            id={selectedId}
+            open={isDeleteDialogOpen}
+            onOpenChange={handleDeleteDialogOpenChange}
+            label="event"
+            deleteFn={removeEvent}
+            removeFromStore={remove}
+          />
+        </>
+      )}
+    </>
+  )
+}
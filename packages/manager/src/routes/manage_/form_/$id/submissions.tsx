@@ -1,11 +1,23 @@
 'use client'
 
 import authGuard from '@/lib/auth-guard'
-import { createFileRoute } from '@tanstack/react-router'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent } from '@/components/ui/card'
+import { Content } from '@/components/content'
+import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { fetchSubmissions, searchSubmissions } from '@/api/form'
 import { Header } from '@/components/header'
+import { Input } from '@/components/ui/input'
+import { Inbox, Search } from 'lucide-react'
 import permissionGuard from '@/lib/permission-guard'
 import { READ_PERMISSION } from '@/stores/self'
-import { Content } from '@/components/content'
+import { Spinner } from '@/components/ui/spinner'
+import type { Submission } from '@/types/form'
+import { SubmissionsTable } from '@/components/form/submissions-table'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useEffect, useState, type FormEvent } from 'react'
+import useSelfStore from '@/stores/self'
 
 export const Route = createFileRoute('/manage_/form_/$id/submissions')({
   component: FormSubmissions,
@@ -21,13 +33,104 @@ export const Route = createFileRoute('/manage_/form_/$id/submissions')({
 })
 
 function FormSubmissions() {
-  // const { id } = Route.useParams()
+  const { id } = Route.useParams()
+  const navigate = useNavigate()
+  const { token } = useSelfStore()
+
+  const [submissions, setSubmissions] = useState<Array<Submission>>([])
+  const [reference, setReference] = useState<string>('')
+  const [isHydrated, setIsHydrated] = useState<boolean>(false)
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+
+  const loadSubmissions = async (search: string) => {
+    setIsLoading(true)
+
+    try {
+      if (search.trim().length > 0) {
+        const found = await searchSubmissions(token || '', search.trim())
+        setSubmissions(found.filter((submission) => submission.formId === Number(id)))
+      } else {
+        setSubmissions(await fetchSubmissions(token || '', Number(id)))
+      }
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      } else {
+        // Display error
+      }
+    } finally {
+      setIsHydrated(true)
+      setIsLoading(false)
+    }
+  }
+
+  useEffect(() => {
+    if (!isHydrated) {
+      loadSubmissions('')
+    }
+  })
+
+  const handleSearch = (event: FormEvent<HTMLFormElement>) => {
+    event.preventDefault()
+    loadSubmissions(reference)
+  }
+
+  const handleClear = () => {
+    setReference('')
+    loadSubmissions('')
+  }
 
   return (
     <>
-      <Header>Form Submissions</Header>
+      <Header backTo="/manage/form">Form Submissions</Header>
+
+      <Content>
+        <form className="mb-6 flex gap-2" onSubmit={handleSearch}>
+          <Input
+            placeholder="Search by reference, e.g. OC-7K2QD"
+            value={reference}
+            onChange={(event) => setReference(event.target.value)}
+            className="max-w-xs"
+          />
+          <Button type="submit" disabled={isLoading}>
+            <Search />
+            Search
+          </Button>
+          {reference.length > 0 && (
+            <Button type="button" variant="secondary" onClick={handleClear} disabled={isLoading}>
+              Clear
+            </Button>
+          )}
+        </form>
+
+        <Card className="p-0">
+          <CardContent className="p-0">
+            {isLoading && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Spinner />
+                  </EmptyMedia>
+                  <EmptyTitle>Loading submissions...</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && submissions.length === 0 && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Inbox />
+                  </EmptyMedia>
+                  <EmptyTitle>No submissions found</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
 
-      <Content></Content>
+            {!isLoading && submissions.length > 0 && <SubmissionsTable data={submissions} />}
+          </CardContent>
+        </Card>
+      </Content>
     </>
   )
 }
@@ -0,0 +1,164 @@
+'use client'
+
+import authGuard from '@/lib/auth-guard'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent } from '@/components/ui/card'
+import { Content } from '@/components/content'
+import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { fetchOutboundEmails, retryOutboundEmail } from '@/api/outbound-email'
+import { Header } from '@/components/header'
+import { Inbox, Search } from 'lucide-react'
+import { Input } from '@/components/ui/input'
+import type { OutboundEmail, OutboundEmailStatus } from '@/types/outbound-email'
+import { OutboundEmailsTable } from '@/components/outbox/outbound-emails-table'
+import permissionGuard from '@/lib/permission-guard'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { Spinner } from '@/components/ui/spinner'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useEffect, useState, type FormEvent } from 'react'
+import useSelfStore, { READ_PERMISSION, WRITE_PERMISSION } from '@/stores/self'
+
+const STATUSES: Array<OutboundEmailStatus> = ['pending', 'sending', 'sent', 'failed', 'suppressed']
+
+export const Route = createFileRoute('/manage_/outbox')({
+  component: Outbox,
+  head: () => ({
+    meta: [
+      {
+        title: 'Outbox | OutClimb Management',
+      },
+    ],
+  }),
+  beforeLoad: ({ context, location }) =>
+    Promise.all([authGuard(context, location), permissionGuard(context, 'email', READ_PERMISSION)]),
+})
+
+function Outbox() {
+  const navigate = useNavigate()
+  const { hasPermission, token } = useSelfStore()
+
+  const [outboundEmails, setOutboundEmails] = useState<Array<OutboundEmail>>([])
+  const [status, setStatus] = useState<string>('_all')
+  const [recipient, setRecipient] = useState<string>('')
+  const [isHydrated, setIsHydrated] = useState<boolean>(false)
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+
+  const loadOutboundEmails = async (nextStatus: string, nextRecipient: string) => {
+    setIsLoading(true)
+
+    try {
+      const emails = await fetchOutboundEmails(token || '', {
+        status: nextStatus === '_all' ? undefined : (nextStatus as OutboundEmailStatus),
+        recipient: nextRecipient.trim(),
+      })
+      setOutboundEmails(emails)
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      } else {
+        // Display error
+      }
+    } finally {
+      setIsHydrated(true)
+      setIsLoading(false)
+    }
+  }
+
+  useEffect(() => {
+    if (!isHydrated) {
+      loadOutboundEmails(status, recipient)
+    }
+  })
+
+  const handleStatusChange = (value: string) => {
+    setStatus(value)
+    loadOutboundEmails(value, recipient)
+  }
+
+  const handleSearch = (event: FormEvent<HTMLFormElement>) => {
+    event.preventDefault()
+    loadOutboundEmails(status, recipient)
+  }
+
+  const handleRetry = async (id: number) => {
+    try {
+      const retried = await retryOutboundEmail(token || '', id)
+      setOutboundEmails((prev) => prev.map((email) => (email.id === id ? retried : email)))
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      } else {
+        // Display error
+      }
+    }
+  }
+
+  return (
+    <>
+      <Header>Outbox</Header>
+
+      <Content>
+        <form className="mb-6 flex flex-wrap gap-2" onSubmit={handleSearch}>
+          <Select value={status} onValueChange={handleStatusChange} disabled={isLoading}>
+            <SelectTrigger className="w-40">
+              <SelectValue placeholder="All statuses" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="_all">All statuses</SelectItem>
+              {STATUSES.map((item) => (
+                <SelectItem key={item} value={item} className="capitalize">
+                  {item}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <Input
+            placeholder="Recipient"
+            value={recipient}
+            onChange={(event) => setRecipient(event.target.value)}
+            className="max-w-xs"
+          />
+          <Button type="submit" disabled={isLoading}>
+            <Search />
+            Search
+          </Button>
+        </form>
+
+        <Card className="p-0">
+          <CardContent className="p-0">
+            {isLoading && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Spinner />
+                  </EmptyMedia>
+                  <EmptyTitle>Loading outbox...</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && outboundEmails.length === 0 && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Inbox />
+                  </EmptyMedia>
+                  <EmptyTitle>No emails found</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && outboundEmails.length > 0 && (
+              <OutboundEmailsTable
+                data={outboundEmails}
+                canEdit={hasPermission('email', WRITE_PERMISSION)}
+                onRetry={handleRetry}
+              />
+            )}
+          </CardContent>
+        </Card>
+      </Content>
+    </>
+  )
+}
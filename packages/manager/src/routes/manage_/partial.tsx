@@ -0,0 +1,148 @@
+'use client'
+
+import authGuard from '@/lib/auth-guard'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent } from '@/components/ui/card'
+import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { Content } from '@/components/content'
+import { DeleteDialog } from '@/components/delete-dialog'
+import { EmailPartialEditorDialog } from '@/components/email-partial/email-partial-editor-dialog'
+import { EmailPartialsTable } from '@/components/email-partial/email-partials-table'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { fetchEmailPartials, removeEmailPartial } from '@/api/email-partial'
+import { Header } from '@/components/header'
+import permissionGuard from '@/lib/permission-guard'
+import { Plus, Puzzle } from 'lucide-react'
+import { Spinner } from '@/components/ui/spinner'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCrudDialogs } from '@/lib/use-crud-dialogs'
+import { useEffect, useState } from 'react'
+import useEmailPartialStore from '@/stores/email-partial'
+import useSelfStore, { READ_PERMISSION, WRITE_PERMISSION } from '@/stores/self'
+
+export const Route = createFileRoute('/manage_/partial')({
+  component: Partials,
+  head: () => ({
+    meta: [
+      {
+        title: 'Email Partials | OutClimb Management',
+      },
+    ],
+  }),
+  beforeLoad: ({ context, location }) =>
+    Promise.all([authGuard(context, location), permissionGuard(context, 'email', READ_PERMISSION)]),
+})
+
+function Partials() {
+  const navigate = useNavigate()
+  const { hasPermission, token } = useSelfStore()
+  const { data, isEmpty, list, populate, remove } = useEmailPartialStore()
+
+  const [isHydrated, setIsHydrated] = useState<boolean>(false)
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const {
+    selectedId,
+    isEditorOpen,
+    handleEditorOpenChange,
+    isDeleteDialogOpen,
+    handleCreate,
+    handleEdit,
+    handleDelete,
+    handleDeleteDialogOpenChange,
+  } = useCrudDialogs()
+
+  useEffect(() => {
+    const fetchPartialsFromApi = async () => {
+      setIsLoading(true)
+
+      try {
+        const partials = await fetchEmailPartials(token || '')
+        populate(partials)
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          // Display error
+        }
+      } finally {
+        setIsHydrated(true)
+        setIsLoading(false)
+      }
+    }
+
+    if (!isHydrated) {
+      fetchPartialsFromApi()
+    }
+  })
+
+  return (
+    <>
+      <Header
+        actions={
+          hasPermission('email', WRITE_PERMISSION) && (
+            <Button onClick={handleCreate} disabled={isLoading}>
+              <Plus />
+              Create Partial
+            </Button>
+          )
+        }>
+        Email Partials
+      </Header>
+
+      <Content>
+        <Card className="p-0">
+          <CardContent className="p-0">
+            {isLoading && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Spinner />
+                  </EmptyMedia>
+                  <EmptyTitle>Loading partials...</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && isEmpty() && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Puzzle />
+                  </EmptyMedia>
+                  <EmptyTitle>No partials added</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && !isEmpty() && (
+              <EmailPartialsTable
+                data={list()}
+                canEdit={hasPermission('email', WRITE_PERMISSION)}
+                onEdit={handleEdit}
+                onDelete={handleDelete}
+              />
+            )}
+          </CardContent>
+        </Card>
+      </Content>
+
+      {hasPermission('email', WRITE_PERMISSION) && (
+        <>
+          <EmailPartialEditorDialog
+            open={isEditorOpen}
+            onOpenChange={handleEditorOpenChange}
+            initialPartial={selectedId != null ? data[selectedId] : undefined}
+          />
+          <DeleteDialog
+            id={selectedId}
+            open={isDeleteDialogOpen}
+            onOpenChange={handleDeleteDialogOpenChange}
+            label="partial"
+            deleteFn={removeEmailPartial}
+            removeFromStore={remove}
+          />
+        </>
+      )}
+    </>
+  )
+}
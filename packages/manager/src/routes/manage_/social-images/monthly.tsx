@@ -18,7 +18,7 @@ import { Spinner } from '@/components/ui/spinner'
 import { UnauthorizedError } from '@/errors/unauthorized'
 import { useCallback, useEffect, useMemo, useState } from 'react'
 import useLocationStore from '@/stores/location'
-import useSelfStore, { READ_PERMISSION } from '@/stores/self'
+import useSelfStore, { WRITE_PERMISSION } from '@/stores/self'
 import { Accordion, AccordionContent, AccordionItem, AccordionTrigger } from '@/components/ui/accordion'
 import { Content } from '@/components/content'
 
@@ -32,7 +32,7 @@ export const Route = createFileRoute('/manage_/social-images/monthly')({
     ],
   }),
   beforeLoad: ({ context, location }) =>
-    Promise.all([authGuard(context, location), permissionGuard(context, 'social', READ_PERMISSION)]),
+    Promise.all([authGuard(context, location), permissionGuard(context, 'social', WRITE_PERMISSION)]),
 })
 
 function Monthly() {
@@ -122,7 +122,13 @@ function Monthly() {
   const handleSubmit = async (e: React.SubmitEvent<HTMLFormElement>) => {
     e.preventDefault()
     setIsGenerating(true)
-    await generateSocialImages(formData, sortedLocationList)
+    try {
+      await generateSocialImages(token || '', formData)
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      }
+    }
     setIsGenerating(false)
   }
 
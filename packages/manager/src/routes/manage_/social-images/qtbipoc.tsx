@@ -23,7 +23,7 @@ import { Textarea } from '@/components/ui/textarea'
 import { UnauthorizedError } from '@/errors/unauthorized'
 import { useCallback, useEffect, useMemo, useState } from 'react'
 import useLocationStore from '@/stores/location'
-import useSelfStore, { READ_PERMISSION } from '@/stores/self'
+import useSelfStore, { WRITE_PERMISSION } from '@/stores/self'
 import { generateQtbipocSocialImage } from '@/lib/social-image'
 
 export const Route = createFileRoute('/manage_/social-images/qtbipoc')({
@@ -36,7 +36,7 @@ export const Route = createFileRoute('/manage_/social-images/qtbipoc')({
     ],
   }),
   beforeLoad: ({ context, location }) =>
-    Promise.all([authGuard(context, location), permissionGuard(context, 'social', READ_PERMISSION)]),
+    Promise.all([authGuard(context, location), permissionGuard(context, 'social', WRITE_PERMISSION)]),
 })
 
 function QTBIPOC() {
@@ -96,9 +96,15 @@ function QTBIPOC() {
 
   const handleGenerate = useCallback(async () => {
     setIsGenerating(true)
-    await generateQtbipocSocialImage(formData, sortedLocationList)
+    try {
+      await generateQtbipocSocialImage(token || '', formData)
+    } catch (error) {
+      if (error instanceof UnauthorizedError) {
+        navigate({ to: '/manage/login' })
+      }
+    }
     setIsGenerating(false)
-  }, [formData, setIsGenerating, sortedLocationList])
+  }, [formData, setIsGenerating, token, navigate])
 
   const handleDayChange = useCallback(
     (value: Date) => {
@@ -0,0 +1,142 @@
+'use client'
+
+import authGuard from '@/lib/auth-guard'
+import { Button } from '@/components/ui/button'
+import { Card, CardContent } from '@/components/ui/card'
+import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { Content } from '@/components/content'
+import { DeleteDialog } from '@/components/delete-dialog'
+import { EmailSuppressionDialog } from '@/components/suppression/email-suppression-dialog'
+import { EmailSuppressionsTable } from '@/components/suppression/email-suppressions-table'
+import { Empty, EmptyHeader, EmptyMedia, EmptyTitle } from '@/components/ui/empty'
+import { fetchEmailSuppressions, removeEmailSuppression } from '@/api/email-suppression'
+import { Header } from '@/components/header'
+import { MailX, Plus } from 'lucide-react'
+import permissionGuard from '@/lib/permission-guard'
+import { Spinner } from '@/components/ui/spinner'
+import { UnauthorizedError } from '@/errors/unauthorized'
+import { useCrudDialogs } from '@/lib/use-crud-dialogs'
+import { useEffect, useState } from 'react'
+import useEmailSuppressionStore from '@/stores/email-suppression'
+import useSelfStore, { READ_PERMISSION, WRITE_PERMISSION } from '@/stores/self'
+
+export const Route = createFileRoute('/manage_/suppression')({
+  component: Suppressions,
+  head: () => ({
+    meta: [
+      {
+        title: 'Suppressions | OutClimb Management',
+      },
+    ],
+  }),
+  beforeLoad: ({ context, location }) =>
+    Promise.all([authGuard(context, location), permissionGuard(context, 'email', READ_PERMISSION)]),
+})
+
+function Suppressions() {
+  const navigate = useNavigate()
+  const { hasPermission, token } = useSelfStore()
+  const { isEmpty, list, populate, remove } = useEmailSuppressionStore()
+
+  const [isHydrated, setIsHydrated] = useState<boolean>(false)
+  const [isLoading, setIsLoading] = useState<boolean>(false)
+  const {
+    selectedId,
+    isEditorOpen,
+    handleEditorOpenChange,
+    isDeleteDialogOpen,
+    handleCreate,
+    handleDelete,
+    handleDeleteDialogOpenChange,
+  } = useCrudDialogs()
+
+  useEffect(() => {
+    const fetchSuppressionsFromApi = async () => {
+      setIsLoading(true)
+
+      try {
+        const suppressions = await fetchEmailSuppressions(token || '')
+        populate(suppressions)
+      } catch (error) {
+        if (error instanceof UnauthorizedError) {
+          navigate({ to: '/manage/login' })
+        } else {
+          // Display error
+        }
+      } finally {
+        setIsHydrated(true)
+        setIsLoading(false)
+      }
+    }
+
+    if (!isHydrated) {
+      fetchSuppressionsFromApi()
+    }
+  })
+
+  return (
+    <>
+      <Header
+        actions={
+          hasPermission('email', WRITE_PERMISSION) && (
+            <Button onClick={handleCreate} disabled={isLoading}>
+              <Plus />
+              Suppress Address
+            </Button>
+          )
+        }>
+        Suppressions
+      </Header>
+
+      <Content>
+        <Card className="p-0">
+          <CardContent className="p-0">
+            {isLoading && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <Spinner />
+                  </EmptyMedia>
+                  <EmptyTitle>Loading suppressions...</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && isEmpty() && (
+              <Empty>
+                <EmptyHeader>
+                  <EmptyMedia variant="icon">
+                    <MailX />
+                  </EmptyMedia>
+                  <EmptyTitle>No addresses suppressed</EmptyTitle>
+                </EmptyHeader>
+              </Empty>
+            )}
+
+            {!isLoading && !isEmpty() && (
+              <EmailSuppressionsTable
+                data={list()}
+                canEdit={hasPermission('email', WRITE_PERMISSION)}
+                onDelete={handleDelete}
+              />
+            )}
+          </CardContent>
+        </Card>
+      </Content>
+
+      {hasPermission('email', WRITE_PERMISSION) && (
+        <>
+          <EmailSuppressionDialog open={isEditorOpen} onOpenChange={handleEditorOpenChange} />
+          <DeleteDialog
+            id={selectedId}
+            open={isDeleteDialogOpen}
+            onOpenChange={handleDeleteDialogOpenChange}
+            label="suppression"
+            deleteFn={removeEmailSuppression}
+            removeFromStore={remove}
+          />
+        </>
+      )}
+    </>
+  )
+}
@@ -0,0 +1,4 @@
+import { createCrudStore } from './crud'
+import type { Broadcast } from '@/types/broadcast'
+
+export default createCrudStore<Broadcast>('broadcast')
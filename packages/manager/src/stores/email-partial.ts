@@ -0,0 +1,4 @@
+import { createCrudStore } from './crud'
+import type { EmailPartial } from '@/types/email-partial'
+
+export default createCrudStore<EmailPartial>('email-partial')
@@ -0,0 +1,4 @@
+import { createCrudStore } from './crud'
+import type { EmailSuppression } from '@/types/email-suppression'
+
+export default createCrudStore<EmailSuppression>('email-suppression')
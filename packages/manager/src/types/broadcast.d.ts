@@ -0,0 +1,31 @@
+export type CreateBroadcastResponse = Broadcast
+export type GetBroadcastsResponse = Array<Broadcast>
+
+export interface BroadcastFilter {
+  fieldSlug: string
+  value: string
+}
+
+export interface BroadcastRequest {
+  emailId: number
+  formId: number
+  filters: Array<BroadcastFilter>
+}
+
+export interface BroadcastPreview {
+  recipientCount: number
+}
+
+export interface Broadcast {
+  id: number
+  createdAt: number
+  createdBy: string
+  emailSlug: string
+  formId: number
+  filters: Array<BroadcastFilter>
+  recipientCount: number
+  pending: number
+  sent: number
+  failed: number
+  suppressed: number
+}
@@ -0,0 +1,10 @@
+export type GetDigestPreferenceResponse = DigestPreference
+export type UpdateDigestPreferenceResponse = DigestPreference
+
+export interface DigestPreference {
+  enabled: boolean
+  // 0 is Sunday.
+  weekday: number
+  hour: number
+  lastSentAt?: number
+}
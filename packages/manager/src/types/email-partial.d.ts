@@ -0,0 +1,12 @@
+export type CreateEmailPartialResponse = EmailPartial
+export type GetEmailPartialsResponse = Array<EmailPartial>
+export type UpdateEmailPartialResponse = EmailPartial
+
+export interface EmailPartial {
+  id: number
+  name: string
+  slug: string
+  layout: boolean
+  htmlBody: string
+  textBody: string
+}
@@ -0,0 +1,9 @@
+export type CreateEmailSuppressionResponse = EmailSuppression
+export type GetEmailSuppressionsResponse = Array<EmailSuppression>
+
+export interface EmailSuppression {
+  id: number
+  createdAt: number
+  address: string
+  reason: 'bounce' | 'complaint' | 'manual' | 'unsubscribe'
+}
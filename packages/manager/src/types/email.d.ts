@@ -1,5 +1,11 @@
 export type CreateEmailResponse = Email
 export type GetEmailsResponse = Array<Email>
+export type GetEmailRevisionResponse = EmailRevision
+export type GetEmailRevisionsResponse = Array<EmailRevision>
+export type GetEmailUsageResponse = Array<EmailUsage>
+export type DiffEmailRevisionsResponse = EmailRevisionDiff
+export type PreviewEmailResponse = EmailPreview
+export type RestoreEmailRevisionResponse = Email
 export type UpdateEmailResponse = Email
 
 export interface Email {
@@ -9,4 +15,52 @@ export interface Email {
   subject: string
   htmlBody: string
   textBody: string
+  layoutSlug?: string | null
+  markdownBody?: string | null
+  revision?: number
+}
+
+export interface EmailPreviewRequest {
+  formId: number
+  submissionId?: number
+  values?: Record<string, string>
+  sendTest: boolean
+}
+
+export interface EmailPreview {
+  subject: string
+  htmlBody: string
+  textBody: string
+  errors: Array<{ field: string; line?: number; message: string }>
+  testQueued: boolean
+}
+
+export interface EmailUsage {
+  formId: number
+  formName: string
+  formSlug: string
+  usage: 'confirmation' | 'notification' | 'reminder'
+}
+
+export interface EmailRevision {
+  emailId: number
+  revision: number
+  createdAt: number
+  createdBy: string
+  name: string
+  slug: string
+  layoutSlug: string | null
+  subject: string
+  markdownBody: string | null
+  htmlBody: string
+  textBody: string
+}
+
+export interface EmailRevisionDiff {
+  from: number
+  to: number
+  fields: Array<{
+    field: string
+    lines: Array<{ op: 'equal' | 'insert' | 'delete'; text: string }>
+  }>
 }
@@ -0,0 +1,28 @@
+export type CreateEventResponse = Event
+export type GetEventsResponse = Array<Event>
+export type GetEventResponse = Event
+export type UpdateEventResponse = Event
+
+export interface Event {
+  id: number
+  title: string
+  startsOn: number
+  endsOn?: number
+  locationId?: number
+  imageAssetId?: number
+  description: string
+  formId?: number
+  link: string
+  published: boolean
+  categories: Array<string>
+}
+
+export type GetEventLocationOverridesResponse = Array<EventLocationOverride>
+export type SetEventLocationOverrideResponse = EventLocationOverride
+
+export interface EventLocationOverride {
+  eventKey: string
+  locationId: number | null
+  updatedAt?: number
+  updatedBy?: string
+}
@@ -29,9 +29,20 @@ export interface Form {
   confirmationEmailSlug?: string | null
   notificationEmailTo?: string | null
   notificationEmailSlug?: string | null
+  eventStartsOn?: number | null
+  eventEndsOn?: number | null
+  eventLocationId?: number | null
+  calendarInvite?: boolean
+  reminders?: Array<FormReminder>
   fields: Array<FormField>
 }
 
+export interface FormReminder {
+  emailSlug: string
+  // Minutes from the event start; negative is before it.
+  offsetMinutes: number
+}
+
 export interface SubmissionValue {
   formFieldId: number
   fieldSlug: string
@@ -40,6 +51,8 @@ export interface SubmissionValue {
 
 export interface Submission {
   id: number
+  formId: number
+  reference: string
   submittedOn: number
   values: Array<SubmissionValue>
 }
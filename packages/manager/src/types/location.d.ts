@@ -14,4 +14,7 @@ export interface Location {
   startTime: string
   endTime: string
   description: string
+  latitude?: number
+  longitude?: number
+  keywords: string
 }
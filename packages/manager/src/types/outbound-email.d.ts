@@ -0,0 +1,28 @@
+export type GetOutboundEmailsResponse = Array<OutboundEmail>
+
+export type OutboundEmailStatus = 'pending' | 'sending' | 'sent' | 'failed' | 'suppressed'
+
+export interface OutboundEmail {
+  id: number
+  createdAt: number
+  updatedAt: number
+  emailSlug: string
+  emailRevision: number
+  formId: number | null
+  submissionId: number | null
+  to: Array<string>
+  subject: string
+  htmlBody: string
+  textBody: string
+  status: OutboundEmailStatus
+  attempts: number
+  providerMessageId: string
+  nextAttemptAt: number
+  lastAttemptAt: number
+  lastError: string
+  sentAt: number
+  deliveredAt: number
+  openedAt: number
+  bouncedAt: number
+  complainedAt: number
+}
@@ -1,3 +1,5 @@
+import type { Asset } from '@/types/asset'
+
 export interface EventSocialImageFormData {
   day?: Date
   startTime: string
@@ -24,3 +26,32 @@ export interface QtbipocSocialImageFormData {
 export interface SocialImageFieldData extends GeneralSocialImageFormData {
   events: Array<EventSocialImageFormData>
 }
+
+export interface SocialImageEventRequest {
+  day: string
+  startTime: string
+  endTime: string
+  locationId: number
+  address: string
+  description: string
+}
+
+export interface MonthlySocialImageRequest {
+  year: number
+  month: number
+  events: Array<SocialImageEventRequest>
+}
+
+export interface QtbipocSocialImageRequest {
+  day: string
+  startTime: string
+  endTime: string
+  whenDescription: string
+  locationId: number
+  cost: string
+}
+
+export interface SaveSocialImagesResponse {
+  fileName: string
+  assets: Array<Asset>
+}